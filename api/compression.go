@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Content codings supported by the server.
+const (
+	encodingZstd   = "zstd"
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// supportedEncodings lists the content codings in order of server preference, used to break ties in client q-values.
+var supportedEncodings = []string{encodingZstd, encodingBrotli, encodingGzip}
+
+// compressibleTypes are the media types whose responses are compressed on the fly.
+var compressibleTypes = []string{
+	"application/json",
+	"application/x-yaml",
+	"application/yaml",
+	"application/x-ndjson",
+	"text/csv",
+	"application/msgpack",
+	"application/cbor",
+}
+
+// MARK: Negotiation
+
+// negotiateEncoding picks the content coding to use for a response from an Accept-Encoding header.
+// Only codings in offered are considered; an empty string means the response is sent uncompressed.
+func negotiateEncoding(acceptEncoding string, offered []string) string {
+	if acceptEncoding == "" {
+		return ""
+	}
+
+	qualities := make(map[string]float64)
+	wildcard := -1.0
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+
+		if name == "*" {
+			wildcard = q
+		} else {
+			qualities[name] = q
+		}
+	}
+
+	best, bestQ := "", 0.0
+	for _, encoding := range supportedEncodings {
+		if !slices.Contains(offered, encoding) {
+			continue
+		}
+		q, ok := qualities[encoding]
+		if !ok {
+			q = wildcard
+		}
+		if q > bestQ {
+			best, bestQ = encoding, q
+		}
+	}
+
+	return best
+}
+
+// MARK: Precompressed Files
+
+// sidecarExtensions maps content codings to the extensions of precompressed sidecar files, e.g. data.sqlite.zst.
+var sidecarExtensions = map[string]string{
+	encodingZstd:   ".zst",
+	encodingBrotli: ".br",
+	encodingGzip:   ".gz",
+}
+
+// findSidecar looks for a precompressed copy of a file in a coding the client accepts.
+// It returns the sidecar's path, file info and coding, or an empty path if none can be served.
+// Sidecars older than the file itself are ignored as stale.
+func findSidecar(filePath string, info os.FileInfo, acceptEncoding string) (string, os.FileInfo, string) {
+	var offered []string
+	sidecars := make(map[string]os.FileInfo)
+
+	for _, encoding := range supportedEncodings {
+		sidecarInfo, err := os.Stat(filePath + sidecarExtensions[encoding])
+		if err != nil || sidecarInfo.IsDir() || sidecarInfo.ModTime().Before(info.ModTime()) {
+			continue
+		}
+		offered = append(offered, encoding)
+		sidecars[encoding] = sidecarInfo
+	}
+
+	encoding := negotiateEncoding(acceptEncoding, offered)
+	if encoding == "" {
+		return "", nil, ""
+	}
+
+	return filePath + sidecarExtensions[encoding], sidecars[encoding], encoding
+}
+
+// MARK: Encoder Pools
+
+var (
+	gzipWriters   = sync.Pool{New: func() any { return gzip.NewWriter(io.Discard) }}
+	brotliWriters = sync.Pool{New: func() any { return brotli.NewWriterLevel(io.Discard, 5) }}
+	zstdWriters   = sync.Pool{New: func() any {
+		encoder, _ := zstd.NewWriter(io.Discard, zstd.WithEncoderConcurrency(1))
+		return encoder
+	}}
+)
+
+// encoder is the common interface of the pooled compressors.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// acquireEncoder takes a compressor for the given coding from its pool and points it at w.
+func acquireEncoder(encoding string, w io.Writer) encoder {
+	switch encoding {
+	case encodingZstd:
+		enc := zstdWriters.Get().(*zstd.Encoder)
+		enc.Reset(w)
+		return enc
+	case encodingBrotli:
+		enc := brotliWriters.Get().(*brotli.Writer)
+		enc.Reset(w)
+		return enc
+	default:
+		enc := gzipWriters.Get().(*gzip.Writer)
+		enc.Reset(w)
+		return enc
+	}
+}
+
+// releaseEncoder detaches a closed compressor from its response and returns it to its pool.
+func releaseEncoder(enc encoder) {
+	switch e := enc.(type) {
+	case *zstd.Encoder:
+		e.Reset(io.Discard)
+		zstdWriters.Put(e)
+	case *brotli.Writer:
+		e.Reset(io.Discard)
+		brotliWriters.Put(e)
+	case *gzip.Writer:
+		e.Reset(io.Discard)
+		gzipWriters.Put(e)
+	}
+}
+
+// MARK: Compressing Writer
+
+// compressWriter compresses the body of JSON, YAML, NDJSON, CSV, MessagePack and CBOR responses with the negotiated coding.
+// The decision is made on the first write, once the handler has set the Content-Type.
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding string
+	decided  bool
+	enc      encoder
+}
+
+// decide checks whether the response qualifies for compression and prepares the headers if so.
+func (w *compressWriter) decide() {
+	if w.decided {
+		return
+	}
+	w.decided = true
+
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" || w.Status() == http.StatusNotModified || w.Status() == http.StatusNoContent {
+		return
+	}
+
+	mediaType, _, _ := strings.Cut(header.Get("Content-Type"), ";")
+	if !slices.Contains(compressibleTypes, strings.TrimSpace(mediaType)) {
+		return
+	}
+
+	header.Set("Content-Encoding", w.encoding)
+	header.Del("Content-Length")
+
+	// Each coding is a different representation, so a strong validator no longer applies.
+	if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		header.Set("ETag", "W/"+etag)
+	}
+
+	w.enc = acquireEncoder(w.encoding, w.ResponseWriter)
+}
+
+// Write compresses data if the response qualifies, otherwise passes it through.
+func (w *compressWriter) Write(data []byte) (int, error) {
+	w.decide()
+	if w.enc == nil {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.enc.Write(data)
+}
+
+// WriteString compresses s if the response qualifies, otherwise passes it through.
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush sends all compressed output produced so far to the client.
+func (w *compressWriter) Flush() {
+	if w.enc != nil {
+		_ = w.enc.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// close finishes the compressed stream and returns the compressor to its pool.
+func (w *compressWriter) close() {
+	if w.enc == nil {
+		return
+	}
+	_ = w.enc.Close()
+	releaseEncoder(w.enc)
+	w.enc = nil
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/zstd"
+)
+
+// MARK: Negotiation
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		offered        []string
+		want           string
+	}{
+		{"", supportedEncodings, ""},
+		{"identity", supportedEncodings, ""},
+		{"gzip", supportedEncodings, encodingGzip},
+		{"gzip, deflate, br", supportedEncodings, encodingBrotli},
+		{"gzip, br, zstd", supportedEncodings, encodingZstd},
+		{"zstd;q=0.5, gzip", supportedEncodings, encodingGzip},
+		{"GZIP;q=0.8, br;q=0.9", supportedEncodings, encodingBrotli},
+		{"*", supportedEncodings, encodingZstd},
+		{"*, zstd;q=0", supportedEncodings, encodingBrotli},
+		{"br;q=0", supportedEncodings, ""},
+		{"br;q=abc, gzip", supportedEncodings, encodingGzip},
+		{"zstd, br", []string{encodingGzip}, ""},
+		{"zstd, gzip", []string{encodingGzip}, encodingGzip},
+	}
+
+	for _, tt := range tests {
+		if got := negotiateEncoding(tt.acceptEncoding, tt.offered); got != tt.want {
+			t.Errorf("negotiateEncoding(%q, %v) = %q, want %q", tt.acceptEncoding, tt.offered, got, tt.want)
+		}
+	}
+}
+
+// MARK: Compressing Writer
+
+// decoders undo each content coding of a response body.
+var decoders = map[string]func(io.Reader) (io.Reader, error){
+	encodingGzip: func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) },
+	encodingBrotli: func(r io.Reader) (io.Reader, error) {
+		return brotli.NewReader(r), nil
+	},
+	encodingZstd: func(r io.Reader) (io.Reader, error) {
+		decoder, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	},
+}
+
+// serveCompressed runs a request through the compression middleware in front of the given handler.
+func serveCompressed(handler gin.HandlerFunc, acceptEncoding string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(SetupCompression())
+	r.GET("/", handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", acceptEncoding)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestSetupCompression(t *testing.T) {
+	body := strings.Repeat(`{"singular":"Haus","plural":"Häuser"}`, 100)
+	handler := func(c *gin.Context) {
+		c.Header("ETag", `"v1"`)
+		c.Data(http.StatusOK, "application/json; charset=utf-8", []byte(body))
+	}
+
+	for _, encoding := range supportedEncodings {
+		t.Run(encoding, func(t *testing.T) {
+			w := serveCompressed(handler, encoding)
+			if got := w.Header().Get("Content-Encoding"); got != encoding {
+				t.Fatalf("Content-Encoding = %q, want %q", got, encoding)
+			}
+			if got := w.Header().Get("ETag"); got != `W/"v1"` {
+				t.Errorf("ETag = %q, want the weak validator", got)
+			}
+			if !strings.Contains(w.Header().Get("Vary"), "Accept-Encoding") {
+				t.Errorf("Vary = %q, want Accept-Encoding", w.Header().Get("Vary"))
+			}
+
+			reader, err := decoders[encoding](w.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(decoded) != body {
+				t.Errorf("decoded body differs from the response, got %d bytes", len(decoded))
+			}
+		})
+	}
+}
+
+func TestSetupCompressionSkipsUnsuitableResponses(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+		accept  string
+		want    string
+	}{
+		{"no accepted coding", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) }, "identity", ""},
+		{"binary body", func(c *gin.Context) { c.Data(http.StatusOK, "application/octet-stream", []byte("SQLite format 3")) }, "gzip", ""},
+		{"not modified", func(c *gin.Context) {
+			c.Header("Content-Type", "application/json")
+			c.AbortWithStatus(http.StatusNotModified)
+		}, "gzip", ""},
+		{"already coded", func(c *gin.Context) {
+			c.Header("Content-Encoding", encodingZstd)
+			c.Data(http.StatusOK, "application/json", []byte("{}"))
+		}, "gzip", encodingZstd},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveCompressed(tt.handler, tt.accept)
+			if got := w.Header().Get("Content-Encoding"); got != tt.want {
+				t.Errorf("Content-Encoding = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// MARK: Precompressed Files
+
+func TestFindSidecar(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "DELanguageData.sqlite")
+	for _, name := range []string{"DELanguageData.sqlite", "DELanguageData.sqlite.zst", "DELanguageData.sqlite.gz"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The gzip sidecar predates the file and is stale.
+	now := time.Now()
+	if err := os.Chtimes(filePath+".gz", now.Add(-time.Hour), now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		acceptEncoding string
+		wantPath       string
+		wantEncoding   string
+	}{
+		{"zstd, gzip", filePath + ".zst", encodingZstd},
+		{"br, zstd;q=0.5", filePath + ".zst", encodingZstd},
+		{"gzip", "", ""},
+		{"br", "", ""},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		path, sidecarInfo, encoding := findSidecar(filePath, info, tt.acceptEncoding)
+		if path != tt.wantPath || encoding != tt.wantEncoding {
+			t.Errorf("findSidecar(%q) = %q, %q, want %q, %q", tt.acceptEncoding, path, encoding, tt.wantPath, tt.wantEncoding)
+		}
+		if path != "" && sidecarInfo.Name() != filepath.Base(path) {
+			t.Errorf("findSidecar(%q) returned the info of %s", tt.acceptEncoding, sidecarInfo.Name())
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dbqueries
+
+import (
+	"fmt"
+
+	"github.com/scribe-org/scribe-server/database"
+)
+
+// GetLanguageTableCoverage counts the rows of a specific language table and the rows holding a value for each column.
+// The lexeme ID and modification time are left out as they are set for every row.
+func GetLanguageTableCoverage(lang, dataType string) (int, map[string]int, error) {
+	tableName, err := languageTableName(lang, dataType)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	schema, err := database.GetTableSchema(tableName)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error fetching schema for %s: %w", tableName, err)
+	}
+
+	columns, err := database.GetTableColumns(tableName)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error fetching columns for %s: %w", tableName, err)
+	}
+
+	fieldColumns := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if column != database.LexemeIDColumn && column != database.LastModifiedColumn {
+			fieldColumns = append(fieldColumns, column)
+		}
+	}
+
+	return database.GetColumnCoverage(tableName, fieldColumns, schema)
+}
@@ -6,52 +6,186 @@ package dbqueries
 
 import (
 	"fmt"
-	"strings"
+	"slices"
+	"time"
 
 	"github.com/scribe-org/scribe-server/database"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
-// GetLanguageTableData fetches data for a specific language table.
-func GetLanguageTableData(lang, dataType string) (map[string]any, error) {
-	// Construct table name with the new format: ENLanguageDataNounsScribe.
+// GetLanguageTableSchema fetches the column schema of a specific language table.
+func GetLanguageTableSchema(lang, dataType string) (map[string]string, error) {
+	tableName, err := languageTableName(lang, dataType)
+	if err != nil {
+		return nil, err
+	}
 
-	caser := cases.Title(language.English)
+	schema, err := database.GetTableSchema(tableName)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching schema for %s: %w", tableName, err)
+	}
 
-	tableName := fmt.Sprintf("%sLanguageData%sScribe",
-		strings.ToUpper(lang),
-		caser.String(dataType),
-	)
+	return schema, nil
+}
 
-	// Validate table name format and existence.
-	if !database.IsValidTableName(tableName) {
-		return nil, fmt.Errorf("invalid table name format: %s", tableName)
+// GetLanguageTableKeyColumn returns the column the rows of a specific language table are ordered and paged by.
+func GetLanguageTableKeyColumn(lang, dataType string) (string, error) {
+	tableName, err := languageTableName(lang, dataType)
+	if err != nil {
+		return "", err
 	}
 
-	// Check if table exists.
-	exists, err := database.TableExists(tableName)
+	keyColumn, err := database.GetTableKeyColumn(tableName)
 	if err != nil {
-		return nil, fmt.Errorf("error checking table existence for %s: %w", tableName, err)
+		return "", fmt.Errorf("error fetching key column for %s: %w", tableName, err)
 	}
-	if !exists {
-		return nil, fmt.Errorf("table %s does not exist", tableName)
+
+	return keyColumn, nil
+}
+
+// MARK: Row Streaming
+
+// TableOptions narrows down the rows fetched from a language data table.
+type TableOptions struct {
+	// Whether to continue after the After position rather than start from the first row
+	Resumed bool
+	// Position of the last row already served, only used if Resumed is set
+	After database.RowPosition
+	// Maximum number of rows to fetch, zero for all rows
+	Limit int
+	// Only fetch rows modified in Wikidata or added or changed by a migration after this time, zero for all rows
+	Since time.Time
+	// Columns to fetch, empty for all columns; the key column is always included
+	Columns []string
+	// Conditions all fetched rows must meet
+	Filters []database.Filter
+	// Only fetch rows holding this lemma or form according to the lookup index, empty for all rows
+	Lookup string
+}
+
+// LanguageTableRows is an open stream over the rows of a language data table.
+type LanguageTableRows struct {
+	*database.RowIterator
+	// Lexeme IDs removed since the delta sync time, only set on the first page of a delta request
+	Deleted []string
+}
+
+// OpenLanguageTableRows opens a stream over the rows of a specific language table selected by opts.
+// Rows are ordered by the table's key column and row ID. The stream must be closed by the caller.
+func OpenLanguageTableRows(lang, dataType string, opts TableOptions) (*LanguageTableRows, error) {
+	tableName, err := languageTableName(lang, dataType)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get table schema.
-	schema, err := database.GetTableSchema(tableName)
+	columns, err := database.GetTableColumns(tableName)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching schema for %s: %w", tableName, err)
+		return nil, fmt.Errorf("error fetching columns for %s: %w", tableName, err)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s has no columns", tableName)
 	}
 
-	// Get data from table.
-	data, err := database.GetTableData(tableName)
+	// Rows count as changed if Wikidata modified them or a migration recorded their lexeme as added or changed.
+	// Tables with neither modification times nor recorded lexemes cannot be diffed, so they are served in full.
+	sinceModified := slices.Contains(columns, database.LastModifiedColumn)
+	sinceUpserted := false
+	if !opts.Since.IsZero() && slices.Contains(columns, database.LexemeIDColumn) {
+		if sinceUpserted, err = database.TableExists(database.UpsertsTable); err != nil {
+			return nil, err
+		}
+	}
+
+	keyColumn := database.KeyColumnOf(columns)
+	hasRowID, err := database.HasRowID(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []string
+	if len(opts.Columns) > 0 {
+		selected = []string{keyColumn}
+		for _, column := range opts.Columns {
+			if !slices.Contains(columns, column) {
+				return nil, fmt.Errorf("column %s does not exist in %s", column, tableName)
+			}
+			if column != keyColumn {
+				selected = append(selected, column)
+			}
+		}
+	}
+
+	for _, filter := range opts.Filters {
+		if !slices.Contains(columns, filter.Column) {
+			return nil, fmt.Errorf("column %s does not exist in %s", filter.Column, tableName)
+		}
+	}
+
+	var deleted []string
+	if !opts.Since.IsZero() && !opts.Resumed {
+		deleted, err = database.GetTombstones(tableName, opts.Since)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching deleted rows for %s: %w", tableName, err)
+		}
+	}
+
+	var lookup *database.FormLookup
+	if opts.Lookup != "" {
+		lookup = &database.FormLookup{Language: lang, DataType: dataType, Form: opts.Lookup}
+	}
+
+	rows, err := database.OpenTableRows(database.TableQuery{
+		TableName:     tableName,
+		KeyColumn:     keyColumn,
+		HasRowID:      hasRowID,
+		Resumed:       opts.Resumed,
+		After:         opts.After,
+		Limit:         opts.Limit,
+		Since:         opts.Since,
+		SinceModified: sinceModified,
+		SinceUpserted: sinceUpserted,
+		Columns:       selected,
+		Filters:       opts.Filters,
+		Lookup:        lookup,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("error fetching data for %s: %w", tableName, err)
 	}
 
-	return map[string]any{
-		"schema": schema,
-		"data":   data,
+	return &LanguageTableRows{
+		RowIterator: rows,
+		Deleted:     deleted,
 	}, nil
 }
+
+// LanguageTableSupportsPaging reports whether the rows of a specific language table can be paged through.
+// Tables migrated before the row ID column existed cannot, until they are migrated again.
+func LanguageTableSupportsPaging(lang, dataType string) (bool, error) {
+	tableName, err := languageTableName(lang, dataType)
+	if err != nil {
+		return false, err
+	}
+
+	return database.HasRowID(tableName)
+}
+
+// languageTableName builds the table name for a language data type and checks that it exists.
+func languageTableName(lang, dataType string) (string, error) {
+	// Construct table name with the new format: ENLanguageDataNounsScribe.
+	tableName := database.LanguageTableName(lang, dataType)
+
+	// Validate table name format and existence.
+	if !database.IsValidTableName(tableName) {
+		return "", fmt.Errorf("invalid table name format: %s", tableName)
+	}
+
+	// Check if table exists.
+	exists, err := database.TableExists(tableName)
+	if err != nil {
+		return "", fmt.Errorf("error checking table existence for %s: %w", tableName, err)
+	}
+	if !exists {
+		return "", fmt.Errorf("table %s does not exist", tableName)
+	}
+
+	return tableName, nil
+}
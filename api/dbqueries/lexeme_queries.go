@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dbqueries
+
+import (
+	"fmt"
+
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// translationsDataType is the data type reported for rows found in translation tables.
+const translationsDataType = "translations"
+
+// FindLexemeRows searches all language data and translation tables with a lexeme ID column for rows of a lexeme.
+// At most limit rows are returned per table, and tables without matches are left out.
+func FindLexemeRows(lexemeID string, limit int) ([]models.LexemeMatch, error) {
+	tables, err := database.GetTablesWithColumn(database.LexemeIDColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []models.LexemeMatch{}
+	for _, tableName := range tables {
+		match, ok := lexemeMatchFor(tableName)
+		if !ok {
+			continue
+		}
+
+		rows, err := database.FindRowsByValue(tableName, database.LexemeIDColumn, []string{database.LexemeIDColumn}, lexemeID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("error searching %s: %w", tableName, err)
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		schema, err := database.GetTableSchema(tableName)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching schema for %s: %w", tableName, err)
+		}
+
+		match.Fields = schema
+		match.Rows = rows
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
+
+// lexemeMatchFor describes the language and data type of a table, or returns false if it is not served.
+func lexemeMatchFor(tableName string) (models.LexemeMatch, bool) {
+	if lang, dataType, ok := database.ParseLanguageTableName(tableName); ok {
+		return models.LexemeMatch{Language: lang, DataType: dataType}, true
+	}
+
+	if target, source, ok := database.ParseTranslationTableName(tableName); ok {
+		return models.LexemeMatch{Language: source, TargetLanguage: target, DataType: translationsDataType}, true
+	}
+
+	return models.LexemeMatch{}, false
+}
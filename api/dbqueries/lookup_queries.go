@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dbqueries
+
+import (
+	"github.com/scribe-org/scribe-server/database"
+)
+
+// LookupIndexAvailable reports whether the migration built the index word lookups are served from.
+// Databases migrated before the index existed have none, and lookups find nothing until they are migrated again.
+func LookupIndexAvailable() (bool, error) {
+	return database.TableExists(database.LookupFormsTable)
+}
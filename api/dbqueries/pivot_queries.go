@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dbqueries
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// preferredPivotLang is tried first when choosing an intermediate language automatically.
+const preferredPivotLang = "en"
+
+// MARK: Pivot Translation
+
+// GetPivotTranslationTableData composes translation data from sourceLang into targetLang through pivotLang.
+// Source entries are joined with the pivot table on their translation and word type; if the intermediate word
+// has several senses, the first one is used. Entries are nested like GetTranslationTableData and marked as pivoted.
+// Pages and word type filters given by opts apply to the source words.
+func GetPivotTranslationTableData(targetLang, sourceLang, pivotLang string, opts TranslationOptions) (TranslationData, string, error) {
+	firstTable, err := translationTableName(pivotLang, sourceLang)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secondTable, err := translationTableName(targetLang, pivotLang)
+	if err != nil {
+		return nil, "", err
+	}
+
+	conditions, args, nextWord, err := opts.conditions(firstTable, "s.")
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := fmt.Sprintf(
+		"SELECT s.word, s.wordType, s.wordOrder, s.description, t.translation, s.translation "+
+			"FROM `%s` s JOIN `%s` t ON t.word = s.translation AND t.wordType = s.wordType",
+		firstTable, secondTable,
+	)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	// wordOrder is stored as text, so it is cast to sort "10" after "2" when picking the first sense.
+	query += " ORDER BY s.word, s.wordType, s.wordOrder, CAST(t.wordOrder AS UNSIGNED)"
+
+	rows, err := database.DB.Query(query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("error joining %s and %s: %w", firstTable, secondTable, err)
+	}
+	defer rows.Close()
+
+	data, err := scanPivotTranslations(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return data, nextWord, nil
+}
+
+// scanPivotTranslations reads joined translation rows into nested translation data, keeping the first row per entry.
+func scanPivotTranslations(rows *sql.Rows) (TranslationData, error) {
+	result := make(TranslationData)
+
+	for rows.Next() {
+		var word, wordType, wordOrder, description, translation, via string
+		if err := rows.Scan(&word, &wordType, &wordOrder, &description, &translation, &via); err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+
+		if result[word] == nil {
+			result[word] = make(map[string]map[string]models.TranslationEntry)
+		}
+		if result[word][wordType] == nil {
+			result[word][wordType] = make(map[string]models.TranslationEntry)
+		}
+		if _, ok := result[word][wordType][wordOrder]; ok {
+			continue
+		}
+		result[word][wordType][wordOrder] = models.TranslationEntry{
+			Description: description,
+			Translation: translation,
+			Pivoted:     true,
+			Via:         via,
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// FindPivotLanguage picks an intermediate language with translation data from sourceLang and into targetLang.
+// English is preferred, otherwise the first such language in alphabetical order is used.
+// An empty string is returned if no translation path through a single language exists.
+func FindPivotLanguage(targetLang, sourceLang string) (string, error) {
+	tables, err := database.ListTranslationTables()
+	if err != nil {
+		return "", err
+	}
+
+	fromSource := make(map[string]bool)
+	intoTarget := make(map[string]bool)
+	for _, tableName := range tables {
+		target, source, ok := database.ParseTranslationTableName(tableName)
+		if !ok {
+			continue
+		}
+		if source == strings.ToLower(sourceLang) {
+			fromSource[target] = true
+		}
+		if target == strings.ToLower(targetLang) {
+			intoTarget[source] = true
+		}
+	}
+
+	if fromSource[preferredPivotLang] && intoTarget[preferredPivotLang] {
+		return preferredPivotLang, nil
+	}
+
+	for _, tableName := range tables {
+		pivotLang, _, _ := database.ParseTranslationTableName(tableName)
+		if fromSource[pivotLang] && intoTarget[pivotLang] {
+			return pivotLang, nil
+		}
+	}
+
+	return "", nil
+}
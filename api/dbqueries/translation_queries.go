@@ -3,43 +3,205 @@
 package dbqueries
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/scribe-org/scribe-server/database"
 	"github.com/scribe-org/scribe-server/models"
 )
 
+// TranslationData is translation data nested as: word -> wordType -> wordOrder -> TranslationEntry.
+type TranslationData = map[string]map[string]map[string]models.TranslationEntry
+
+// translationColumns are the columns read from translation tables, in scan order.
+const translationColumns = "word, wordType, wordOrder, description, translation"
+
+// TranslationOptions narrows down the entries fetched from a translation table.
+type TranslationOptions struct {
+	// Last source word of the previous page, empty to start from the first word
+	AfterWord string
+	// Maximum number of source words, zero for all words
+	Limit int
+	// Only fetch entries of this word type, empty for all word types
+	WordType string
+}
+
 // GetTranslationTableData fetches translation data for the given target and source language codes.
 // It queries the TranslationData{TARGET}From{SOURCE} table and returns data nested as:
 // word -> wordType -> wordOrder -> TranslationEntry.
-func GetTranslationTableData(targetLang, sourceLang string) (map[string]map[string]map[string]models.TranslationEntry, error) {
+// When opts sets a limit, the last word of a page that is followed by more words is returned as well.
+func GetTranslationTableData(targetLang, sourceLang string, opts TranslationOptions) (TranslationData, string, error) {
+	tableName, err := translationTableName(targetLang, sourceLang)
+	if err != nil {
+		return nil, "", err
+	}
+
+	conditions, args, nextWord, err := opts.conditions(tableName, "")
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM `%s`", translationColumns, tableName)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY word, wordType, wordOrder"
+
+	rows, err := database.DB.Query(query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("error querying %s: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	data, err := scanTranslations(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return data, nextWord, nil
+}
+
+// FindTranslations fetches the translations of a single source word, nested like GetTranslationTableData.
+// In reverse mode the source words whose translation equals word are returned instead.
+func FindTranslations(targetLang, sourceLang, word string, reverse bool, limit int) (TranslationData, error) {
+	tableName, err := translationTableName(targetLang, sourceLang)
+	if err != nil {
+		return nil, err
+	}
+
+	column := "word"
+	if reverse {
+		column = "translation"
+	}
+
+	rows, err := database.DB.Query(
+		fmt.Sprintf("SELECT %s FROM `%s` WHERE `%s` = ? ORDER BY word, wordType, wordOrder LIMIT ?", translationColumns, tableName, column),
+		word, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error searching %s: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	return scanTranslations(rows)
+}
+
+// GetTranslationMigrationTime returns when the translation table of a language pair was last migrated.
+func GetTranslationMigrationTime(targetLang, sourceLang string) (time.Time, error) {
+	tableName, err := translationTableName(targetLang, sourceLang)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return database.GetTableMigrationTime(tableName)
+}
+
+// MARK: Word Paging
+
+// conditions renders the word type filter and the word range of the page selected by opts as SQL conditions
+// on the source words of tableName, whose columns are prefixed with alias.
+// It also returns the last word of the page if further words follow.
+func (opts TranslationOptions) conditions(tableName, alias string) ([]string, []any, string, error) {
+	var conditions []string
+	var args []any
+
+	if opts.WordType != "" {
+		conditions = append(conditions, alias+"wordType = ?")
+		args = append(args, opts.WordType)
+	}
+	if opts.AfterWord != "" {
+		conditions = append(conditions, alias+"word > ?")
+		args = append(args, opts.AfterWord)
+	}
+	if opts.Limit <= 0 {
+		return conditions, args, "", nil
+	}
+
+	lastWord, nextWord, err := opts.pageWords(tableName)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if lastWord == "" {
+		return append(conditions, "1 = 0"), args, "", nil
+	}
+
+	conditions = append(conditions, alias+"word <= ?")
+	args = append(args, lastWord)
+
+	return conditions, args, nextWord, nil
+}
+
+// pageWords finds the last source word of the page selected by opts, and the same word again if further words follow.
+// Pages hold whole words, so one word more than the limit is fetched to detect the next page.
+func (opts TranslationOptions) pageWords(tableName string) (string, string, error) {
+	unpaged := opts
+	unpaged.Limit = 0
+	conditions, args, _, err := unpaged.conditions(tableName, "")
+	if err != nil {
+		return "", "", err
+	}
+
+	query := fmt.Sprintf("SELECT DISTINCT word FROM `%s`", tableName)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY word LIMIT ?"
+	args = append(args, opts.Limit+1)
+
+	rows, err := database.DB.Query(query, args...)
+	if err != nil {
+		return "", "", fmt.Errorf("error paging %s: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	var words []string
+	for rows.Next() {
+		var word string
+		if err := rows.Scan(&word); err != nil {
+			return "", "", fmt.Errorf("error scanning word: %w", err)
+		}
+		words = append(words, word)
+	}
+	if err := rows.Err(); err != nil {
+		return "", "", fmt.Errorf("error iterating words: %w", err)
+	}
+
+	if len(words) > opts.Limit {
+		lastWord := words[opts.Limit-1]
+		return lastWord, lastWord, nil
+	}
+	if len(words) == 0 {
+		return "", "", nil
+	}
+	return words[len(words)-1], "", nil
+}
+
+// translationTableName builds the translation table name for a language pair and checks that it exists.
+func translationTableName(targetLang, sourceLang string) (string, error) {
 	tableName := fmt.Sprintf("TranslationData%sFrom%s",
 		strings.ToUpper(targetLang),
 		strings.ToUpper(sourceLang),
 	)
 
 	if !database.IsValidTranslationTableName(tableName) {
-		return nil, fmt.Errorf("invalid translation table name: %s", tableName)
+		return "", fmt.Errorf("invalid translation table name: %s", tableName)
 	}
 
 	exists, err := database.TableExists(tableName)
 	if err != nil {
-		return nil, fmt.Errorf("error checking table existence for %s: %w", tableName, err)
+		return "", fmt.Errorf("error checking table existence for %s: %w", tableName, err)
 	}
 	if !exists {
-		return nil, fmt.Errorf("translation table %s does not exist", tableName)
+		return "", fmt.Errorf("translation table %s does not exist", tableName)
 	}
 
-	rows, err := database.DB.Query(
-		fmt.Sprintf("SELECT word, wordType, wordOrder, description, translation FROM `%s`", tableName),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error querying %s: %w", tableName, err)
-	}
-	defer rows.Close()
+	return tableName, nil
+}
 
-	result := make(map[string]map[string]map[string]models.TranslationEntry)
+// scanTranslations reads translation rows into nested translation data.
+func scanTranslations(rows *sql.Rows) (TranslationData, error) {
+	result := make(TranslationData)
 
 	for rows.Next() {
 		var word, wordType, wordOrder, description, translation string
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// fileETagEntry is a cached content hash along with the file state it was computed for.
+type fileETagEntry struct {
+	size    int64
+	modTime time.Time
+	etag    string
+}
+
+// fileETags caches content hashes of served files by path.
+var fileETags sync.Map
+
+// MARK: File ETags
+
+// fileETag returns a strong ETag for a file's content.
+// The file is only hashed again when its size or modification time changes.
+func fileETag(path string, info os.FileInfo) (string, error) {
+	if cached, ok := fileETags.Load(path); ok {
+		entry := cached.(fileETagEntry)
+		if entry.size == info.Size() && entry.modTime.Equal(info.ModTime()) {
+			return entry.etag, nil
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("could not open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("could not hash %s: %w", path, err)
+	}
+
+	etag := `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+	fileETags.Store(path, fileETagEntry{
+		size:    info.Size(),
+		modTime: info.ModTime(),
+		etag:    etag,
+	})
+
+	return etag, nil
+}
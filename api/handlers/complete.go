@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// MARK: Completions
+
+// GetCompletions returns lemmas and forms of a language's nouns and verbs that start with a prefix.
+//
+// @Summary Complete a word prefix
+// @Description Returns noun and verb lemmas and forms of the given language that start with the prefix, ignoring case. Results come from an index built during data migration and are ordered alphabetically.
+// @Tags Language Data
+// @Accept  json
+// @Produce  json
+// @Param lang path string true "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(de)
+// @Param prefix query string true "Beginning of the word to complete" example(ha)
+// @Param limit query int false "Maximum number of completions (1-100, default 10)" example(10)
+// @Success 200 {object} models.CompletionResponse "Successfully completed the prefix"
+// @Failure 400 {object} models.ErrorResponse "Invalid language code, prefix or limit"
+// @Failure 404 {object} models.ErrorResponse "Language not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error while fetching completions"
+// @Router /api/v1/complete/{lang} [get]
+func GetCompletions(c *gin.Context) {
+	prefix := strings.TrimSpace(c.Query("prefix"))
+
+	if prefix == "" || utf8.RuneCountInString(prefix) > constants.MaxLookupWordLength {
+		HandleError(c, http.StatusBadRequest, constants.InvalidCompletionPrefixError)
+		return
+	}
+
+	limit := constants.DefaultCompletionLimit
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > constants.MaxCompletionLimit {
+			HandleError(c, http.StatusBadRequest, constants.InvalidCompletionLimitError)
+			return
+		}
+		limit = parsed
+	}
+
+	lang, ok := requireLanguage(c, c.Param("lang"))
+	if !ok {
+		return
+	}
+
+	if _, notModified := checkLanguageNotModified(c, lang); notModified {
+		return
+	}
+
+	completions, err := database.GetCompletions(lang, prefix, limit)
+	if err != nil {
+		log.Printf("Error fetching completions for %s/%q: %v", lang, prefix, err)
+		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingCompletions)
+		return
+	}
+
+	HandleSuccess(c, models.CompletionResponse{
+		Language:    lang,
+		Prefix:      prefix,
+		Completions: completions,
+	})
+}
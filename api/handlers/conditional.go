@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+)
+
+// MARK: Entity Tags
+
+// computeETag derives a strong entity tag from the given parts.
+func computeETag(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// flattenVersions renders a versions map in a stable order for hashing.
+func flattenVersions(versions map[string]string) string {
+	keys := make([]string, 0, len(versions))
+	for key := range versions {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(key)
+		b.WriteByte('=')
+		b.WriteString(versions[key])
+		b.WriteByte(';')
+	}
+	return b.String()
+}
+
+// MARK: Conditional Requests
+
+// checkNotModified sets the ETag and Last-Modified headers and answers with 304 Not Modified
+// when the client's cached copy is still current. It returns true if the response was written.
+func checkNotModified(c *gin.Context, etag string, lastModified time.Time) bool {
+	// Each response format is a different representation of the same data.
+	if format := c.GetString(formatContextKey); format != "" {
+		etag = computeETag(etag, format)
+	}
+
+	// API responses may be content-coded by the compression middleware, so the validator is always weak
+	// and a 304 carries the same form as the 200 it revalidates.
+	if !strings.HasPrefix(etag, "W/") {
+		etag = "W/" + etag
+	}
+
+	c.Header("ETag", etag)
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	// If-None-Match takes precedence over If-Modified-Since (RFC 9110, section 13.2.2).
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" {
+		if etagMatches(ifNoneMatch, etag) {
+			c.AbortWithStatus(http.StatusNotModified)
+			return true
+		}
+		return false
+	}
+
+	if ifModifiedSince := c.GetHeader("If-Modified-Since"); ifModifiedSince != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		if err == nil && !lastModified.Truncate(time.Second).After(since) {
+			c.AbortWithStatus(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
+
+// etagMatches applies the weak comparison used for If-None-Match to a header value.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkLanguageNotModified validates a response derived from a language dataset against the client's cache.
+// The ETag covers the dataset version and the request URI, so pages and delta requests are cached separately.
+// It returns when the dataset was last migrated, and true if a 304 response was written.
+func checkLanguageNotModified(c *gin.Context, lang string) (time.Time, bool) {
+	version, err := datasetVersions.get(lang)
+	if err != nil {
+		log.Printf("Error fetching dataset version for %s: %v", lang, err)
+		return time.Time{}, false
+	}
+
+	etag := computeETag(
+		constants.APIVersion,
+		c.Request.URL.RequestURI(),
+		version.versions,
+		version.migratedAt.UTC().Format(time.RFC3339),
+	)
+
+	return version.migratedAt, checkNotModified(c, etag, version.migratedAt)
+}
+
+// checkTranslationNotModified validates a response derived from translation data against the client's cache.
+// The ETag covers the migration time of the data and the request URI. Data migrated at an unknown time is always served.
+// It returns true if a 304 response was written.
+func checkTranslationNotModified(c *gin.Context, migratedAt time.Time) bool {
+	if migratedAt.IsZero() {
+		return false
+	}
+
+	etag := computeETag(constants.APIVersion, c.Request.URL.RequestURI(), migratedAt.UTC().Format(time.RFC3339))
+	return checkNotModified(c, etag, migratedAt)
+}
+
+// MARK: Dataset Versions
+
+// versionRecheckInterval is how long a language's migration time is trusted before it is queried again.
+const versionRecheckInterval = 30 * time.Second
+
+// datasetVersion is the migrated state of a language dataset that its entity tags are derived from.
+type datasetVersion struct {
+	migratedAt time.Time
+	versions   string // flattened last modified dates of the data types
+	checkedAt  time.Time
+}
+
+// versionCache remembers the dataset version of each language, so that conditional requests are answered
+// without scanning the language's tables. The versions are only reloaded once the language was migrated again.
+type versionCache struct {
+	mu            sync.Mutex
+	entries       map[string]datasetVersion
+	recheck       time.Duration
+	migrationTime func(lang string) (time.Time, error)
+	versions      func(lang string) (map[string]string, error)
+}
+
+// datasetVersions is the cache the conditional request checks of the language endpoints use.
+var datasetVersions = newVersionCache(versionRecheckInterval, database.GetLanguageMigrationTime, database.GetLanguageVersions)
+
+// newVersionCache returns an empty cache that loads versions with the given functions.
+func newVersionCache(
+	recheck time.Duration,
+	migrationTime func(lang string) (time.Time, error),
+	versions func(lang string) (map[string]string, error),
+) *versionCache {
+	return &versionCache{
+		entries:       make(map[string]datasetVersion),
+		recheck:       recheck,
+		migrationTime: migrationTime,
+		versions:      versions,
+	}
+}
+
+// get returns the dataset version of a language, querying the migration time at most once per recheck interval
+// and the data type versions only when the migration time changed.
+func (vc *versionCache) get(lang string) (datasetVersion, error) {
+	now := time.Now()
+
+	vc.mu.Lock()
+	entry, ok := vc.entries[lang]
+	vc.mu.Unlock()
+	if ok && now.Sub(entry.checkedAt) < vc.recheck {
+		return entry, nil
+	}
+
+	migratedAt, err := vc.migrationTime(lang)
+	if err != nil {
+		return datasetVersion{}, err
+	}
+
+	if !ok || !migratedAt.Equal(entry.migratedAt) {
+		versions, err := vc.versions(lang)
+		if err != nil {
+			return datasetVersion{}, err
+		}
+		entry = datasetVersion{migratedAt: migratedAt, versions: flattenVersions(versions)}
+	}
+	entry.checkedAt = now
+
+	vc.mu.Lock()
+	vc.entries[lang] = entry
+	vc.mu.Unlock()
+
+	return entry, nil
+}
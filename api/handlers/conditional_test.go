@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// MARK: Conditional Requests
+
+func TestCheckNotModified(t *testing.T) {
+	lastModified := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	etag := computeETag("dataset", "v1")
+
+	// The ETag a client caches from a 200 response.
+	c, w := newTestContext("", "")
+	if checkNotModified(c, etag, lastModified) {
+		t.Fatal("checkNotModified answered a request without validators")
+	}
+	cached := w.Header().Get("ETag")
+	if !strings.HasPrefix(cached, `W/"`) {
+		t.Fatalf("ETag = %q, want a weak validator", cached)
+	}
+	if got := w.Header().Get("Last-Modified"); got != "Sat, 01 Mar 2025 12:00:00 GMT" {
+		t.Errorf("Last-Modified = %q", got)
+	}
+
+	tests := []struct {
+		name            string
+		ifNoneMatch     string
+		ifModifiedSince string
+		want            bool
+	}{
+		{"cached weak ETag", cached, "", true},
+		{"cached ETag without weak prefix", strings.TrimPrefix(cached, "W/"), "", true},
+		{"ETag in a list", `"other", ` + cached, "", true},
+		{"any ETag", "*", "", true},
+		{"other ETag", `W/"other"`, "", false},
+		{"other ETag overrides a current date", `W/"other"`, "Sat, 01 Mar 2025 12:00:00 GMT", false},
+		{"not modified since", "", "Sat, 01 Mar 2025 12:00:00 GMT", true},
+		{"modified since", "", "Sat, 01 Mar 2025 11:59:59 GMT", false},
+		{"invalid date", "", "yesterday", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newTestContext("", "")
+			if tt.ifNoneMatch != "" {
+				c.Request.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			if tt.ifModifiedSince != "" {
+				c.Request.Header.Set("If-Modified-Since", tt.ifModifiedSince)
+			}
+
+			if got := checkNotModified(c, etag, lastModified); got != tt.want {
+				t.Fatalf("checkNotModified = %v, want %v", got, tt.want)
+			}
+			if tt.want && w.Code != http.StatusNotModified {
+				t.Errorf("status = %d, want 304", w.Code)
+			}
+			// The 304 must carry the validator the client cached from the 200.
+			if got := w.Header().Get("ETag"); got != cached {
+				t.Errorf("ETag = %q, want %q", got, cached)
+			}
+		})
+	}
+}
+
+func TestCheckNotModifiedSeparatesFormats(t *testing.T) {
+	etags := map[string]bool{}
+	for _, format := range []responseFormat{formatJSON, formatCSV} {
+		c, w := newTestContext("", "")
+		c.Set(formatContextKey, string(format))
+		checkNotModified(c, computeETag("dataset"), time.Time{})
+		etags[w.Header().Get("ETag")] = true
+	}
+
+	if len(etags) != 2 {
+		t.Errorf("formats share ETags %v", etags)
+	}
+}
+
+// MARK: Dataset Versions
+
+func TestVersionCacheReloadsVersionsPerMigration(t *testing.T) {
+	migratedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	var migrationQueries, versionQueries int
+
+	cache := newVersionCache(time.Hour,
+		func(string) (time.Time, error) {
+			migrationQueries++
+			return migratedAt, nil
+		},
+		func(string) (map[string]string, error) {
+			versionQueries++
+			return map[string]string{"nouns_last_modified": migratedAt.String()}, nil
+		},
+	)
+
+	for range 3 {
+		if _, err := cache.get("de"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if migrationQueries != 1 || versionQueries != 1 {
+		t.Errorf("queried the migration time %d and versions %d times within the recheck interval, want once each",
+			migrationQueries, versionQueries)
+	}
+
+	// Once the interval passed, only the migration time is checked while it is unchanged.
+	cache.recheck = 0
+	first, _ := cache.get("de")
+	if migrationQueries != 2 || versionQueries != 1 {
+		t.Errorf("queried the migration time %d and versions %d times, want 2 and 1", migrationQueries, versionQueries)
+	}
+
+	migratedAt = migratedAt.Add(time.Hour)
+	second, err := cache.get("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if versionQueries != 2 {
+		t.Errorf("queried versions %d times after a migration, want 2", versionQueries)
+	}
+	if !second.migratedAt.Equal(migratedAt) || second.versions == first.versions {
+		t.Errorf("version after a migration = %+v, want it to differ from %+v", second, first)
+	}
+}
+
+func TestVersionCacheDoesNotCacheErrors(t *testing.T) {
+	fail := true
+	cache := newVersionCache(time.Hour,
+		func(string) (time.Time, error) {
+			if fail {
+				return time.Time{}, errors.New("database unavailable")
+			}
+			return time.Unix(0, 0), nil
+		},
+		func(string) (map[string]string, error) { return map[string]string{}, nil },
+	)
+
+	if _, err := cache.get("de"); err == nil {
+		t.Fatal("get succeeded while the database is unavailable")
+	}
+	fail = false
+	if _, err := cache.get("de"); err != nil {
+		t.Errorf("get after the database recovered: %v", err)
+	}
+}
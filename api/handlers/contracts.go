@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"log"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/internal/contracts"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// contractCache holds the parsed contracts served by the API; it is empty until SetContractCache is called.
+var contractCache = new(contracts.Cache)
+
+// SetContractCache sets the cache contracts are served from.
+func SetContractCache(cache *contracts.Cache) {
+	contractCache = cache
+}
+
+// MARK: Contracts Retrieval
+
+// GetContracts returns schema contracts for all languages or a specific one if a query parameter is provided.
+//
+// @Summary Retrieve schema contracts
+// @Description If a 'lang' query parameter is provided, returns the contract for that specific language. Otherwise, returns all contracts.
+// @Description Contracts are served from memory and reloaded when their files change. YAML is returned unless another format is negotiated.
+// @Tags Contracts
+// @Accept  json
+// @Produce  application/x-yaml,json,application/x-ndjson,application/msgpack,application/cbor
+// @Param lang query string false "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(es)
+// @Param format query string false "Response format, overriding the Accept header" Enums(yaml, json, ndjson, msgpack, cbor)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
+// @Success 200 {object} models.ContractsResponse "Successfully retrieved contracts"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the underlying data last changed"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid language code or format provided"
+// @Failure 404 {object} models.ErrorResponse "Language not supported or without contract"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
+// @Router /api/v1/contracts [get]
+func GetContracts(c *gin.Context) {
+	lang := c.Query("lang")
+
+	var selected map[string]*contracts.Contract
+	if lang != "" {
+		contract, ok := requireContract(c, lang)
+		if !ok {
+			return
+		}
+		selected = map[string]*contracts.Contract{contract.Language: contract}
+	} else {
+		selected = contractCache.All()
+	}
+
+	format, ok := negotiateFormat(c, contractFormats)
+	if !ok {
+		return
+	}
+
+	etag, lastModified := contractsFingerprint(selected)
+	if checkNotModified(c, etag, lastModified) {
+		return
+	}
+
+	content := make(map[string]any, len(selected))
+	for code, contract := range selected {
+		content[code] = contract.Content
+	}
+
+	renderResponse(c, format, models.ContractsResponse{
+		Contracts: content,
+	}, func() recordSet { return contractRecords(content) })
+}
+
+// GetContract returns the schema contract of a single language.
+//
+// @Summary Retrieve the schema contract of a language
+// @Description Returns the contract describing the fields of each data type of the given language.
+// @Description Contracts are served from memory and reloaded when their files change. YAML is returned unless another format is negotiated.
+// @Tags Contracts
+// @Accept  json
+// @Produce  application/x-yaml,json,application/x-ndjson,application/msgpack,application/cbor
+// @Param lang path string true "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(de)
+// @Param format query string false "Response format, overriding the Accept header" Enums(yaml, json, ndjson, msgpack, cbor)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
+// @Success 200 {object} models.LanguageContractResponse "Successfully retrieved the contract"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the contract file last changed"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid language code or format"
+// @Failure 404 {object} models.ErrorResponse "Language not supported or without contract"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
+// @Router /api/v1/contracts/{lang} [get]
+func GetContract(c *gin.Context) {
+	contract, ok := requireContract(c, c.Param("lang"))
+	if !ok {
+		return
+	}
+
+	format, ok := negotiateFormat(c, contractFormats)
+	if !ok {
+		return
+	}
+
+	etag, lastModified := contractsFingerprint(map[string]*contracts.Contract{contract.Language: contract})
+	if checkNotModified(c, etag, lastModified) {
+		return
+	}
+
+	response := models.LanguageContractResponse{
+		Language: contract.Language,
+		Contract: contract.Content,
+	}
+	renderResponse(c, format, response, func() recordSet {
+		return recordSet{records: []any{response}}
+	})
+}
+
+// MARK: Contract Validation
+
+// GetContractValidation compares the contract of a language with its database tables.
+//
+// @Summary Validate a contract against the database
+// @Description Reports, per data type, the columns the contract references that the table lacks, the table columns the contract does not mention
+// @Description and the contract columns whose database type does not hold text. Data types without a contract section are listed but not compared.
+// @Tags Contracts
+// @Accept  json
+// @Produce  json
+// @Param lang path string true "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(de)
+// @Success 200 {object} models.ContractValidationResponse "Drift between the contract and the tables"
+// @Failure 400 {object} models.ErrorResponse "Invalid language code"
+// @Failure 404 {object} models.ErrorResponse "Language not supported or without contract"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /api/v1/contracts/{lang}/validate [get]
+func GetContractValidation(c *gin.Context) {
+	contract, ok := requireContract(c, c.Param("lang"))
+	if !ok {
+		return
+	}
+
+	response, err := contracts.Validate(contract)
+	if err != nil {
+		log.Printf("Error validating contract for %s: %v", contract.Language, err)
+		HandleError(c, http.StatusInternalServerError, "Failed to validate contract")
+		return
+	}
+
+	HandleSuccess(c, response)
+}
+
+// MARK: Contract Helpers
+
+// requireContract resolves a language tag onto a supported language and returns its contract.
+// It writes the error response and returns false if the language is not served or has no contract.
+func requireContract(c *gin.Context, tag string) (*contracts.Contract, bool) {
+	lang, ok := requireLanguage(c, tag)
+	if !ok {
+		return nil, false
+	}
+
+	contract, ok := contractCache.Get(lang)
+	if !ok {
+		HandleError(c, http.StatusNotFound, constants.ContractNotFoundError)
+		return nil, false
+	}
+
+	return contract, true
+}
+
+// contractsFingerprint computes an ETag over the content of the given contracts, along with their latest modification time.
+func contractsFingerprint(selected map[string]*contracts.Contract) (string, time.Time) {
+	parts := []string{constants.APIVersion}
+	var lastModified time.Time
+	for _, lang := range slices.Sorted(maps.Keys(selected)) {
+		contract := selected[lang]
+		parts = append(parts, lang, contract.Digest)
+		if contract.ModTime.After(lastModified) {
+			lastModified = contract.ModTime
+		}
+	}
+
+	return computeETag(parts...), lastModified
+}
+
+// contractRecords flattens contracts into one NDJSON record per language, ordered by language code.
+func contractRecords(content map[string]any) recordSet {
+	var set recordSet
+	for _, lang := range slices.Sorted(maps.Keys(content)) {
+		set.records = append(set.records, models.LanguageContractResponse{
+			Language: lang,
+			Contract: content[lang],
+		})
+	}
+	return set
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/internal/contracts"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// germanContract describes the German noun and verb tables of dataTestTables.
+const germanContract = `nouns:
+  numbers:
+    singular: plural
+verbs:
+  conjugations:
+    1:
+      title: Präsens
+      tenses:
+        1:
+          title: Infinitiv
+          tenseForms:
+            ich: infinitive
+`
+
+// useTestContracts serves the handlers from a cache of the given contract files and returns its directory.
+func useTestContracts(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	previous := contractCache
+	SetContractCache(contracts.NewCache(dir))
+	t.Cleanup(func() { SetContractCache(previous) })
+
+	return dir
+}
+
+// MARK: Contracts Retrieval
+
+func TestGetContract(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+	useTestContracts(t, map[string]string{"de.yaml": germanContract})
+
+	params := gin.Params{{Key: "lang", Value: "de-AT"}}
+
+	w := serveTestRequest(GetContract, params, "", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, "application/x-yaml") {
+		t.Errorf("Content-Type = %q, want YAML by default", got)
+	}
+	if !strings.Contains(w.Body.String(), "singular: plural") {
+		t.Errorf("body = %s, want the contract of de", w.Body)
+	}
+
+	w = serveTestRequest(GetContract, params, "", "application/json")
+	if w.Code != http.StatusOK {
+		t.Fatalf("JSON status = %d, body %s", w.Code, w.Body)
+	}
+	var got models.LanguageContractResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	nouns, _ := got.Contract.(map[string]any)["nouns"].(map[string]any)
+	if got.Language != "de" || nouns["numbers"] == nil {
+		t.Errorf("JSON response = %+v, want the contract of de", got)
+	}
+
+	if w := serveTestRequest(GetContract, params, "", "text/html"); w.Code != http.StatusNotAcceptable {
+		t.Errorf("text/html status = %d, want 406", w.Code)
+	}
+
+	etag := w.Header().Get("ETag")
+	c, w := newTestContext("", "application/json")
+	c.Params = params
+	c.Request.Header.Set("If-None-Match", etag)
+	GetContract(c)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("revalidation status = %d, want 304", w.Code)
+	}
+}
+
+func TestGetContractRejectsInvalidRequests(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+	useTestContracts(t, nil)
+
+	tests := []struct {
+		lang string
+		want int
+	}{
+		{"de", http.StatusNotFound},
+		{"xx", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		w := serveTestRequest(GetContract, gin.Params{{Key: "lang", Value: tt.lang}}, "", "")
+		if w.Code != tt.want {
+			t.Errorf("/contracts/%s status = %d, want %d", tt.lang, w.Code, tt.want)
+		}
+	}
+}
+
+func TestGetContractsServesReloadedContracts(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+	dir := useTestContracts(t, map[string]string{"de.yaml": germanContract})
+
+	w := serveTestRequest(GetContracts, nil, "", "application/json")
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" {
+		t.Fatalf("status = %d, ETag %q", w.Code, etag)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "de.yaml"), []byte("nouns:\n  numbers:\n    singular: plural\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := contractCache.Reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, w := newTestContext("", "application/json")
+	c.Request.Header.Set("If-None-Match", etag)
+	GetContracts(c)
+	if w.Code != http.StatusOK || w.Header().Get("ETag") == etag {
+		t.Fatalf("status = %d, ETag %q after reload, want a new representation", w.Code, w.Header().Get("ETag"))
+	}
+
+	var got models.ContractsResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	de, _ := got.Contracts["de"].(map[string]any)
+	if len(got.Contracts) != 1 || de["nouns"] == nil || de["verbs"] != nil {
+		t.Errorf("contracts = %v, want the reloaded contract of de", got.Contracts)
+	}
+}
+
+// MARK: Contract Validation
+
+func TestGetContractValidation(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+	useTestContracts(t, map[string]string{"de.yaml": germanContract})
+
+	w := serveTestRequest(GetContractValidation, gin.Params{{Key: "lang", Value: "de"}}, "", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+
+	var got models.ContractValidationResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	nouns, verbs := got.DataTypes["nouns"], got.DataTypes["verbs"]
+	if got.Language != "de" || got.Valid {
+		t.Errorf("response = %+v, want drift for de", got)
+	}
+	if !reflect.DeepEqual(nouns.UnexpectedColumns, []string{"count"}) || len(nouns.MissingColumns) != 0 {
+		t.Errorf("nouns drift = %+v, want only the unexpected count column", nouns)
+	}
+	if !verbs.InContract || !verbs.HasTable || len(verbs.MissingColumns) != 0 || len(verbs.UnexpectedColumns) != 0 {
+		t.Errorf("verbs drift = %+v, want none", verbs)
+	}
+
+	if w := serveTestRequest(GetContractValidation, gin.Params{{Key: "lang", Value: "sv"}}, "", ""); w.Code != http.StatusBadRequest {
+		t.Errorf("/contracts/sv/validate status = %d, want 400", w.Code)
+	}
+}
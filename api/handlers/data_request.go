@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/dbqueries"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+)
+
+// dataRequest holds the query parameters shared by the language data endpoints.
+type dataRequest struct {
+	// Language code the data is served for
+	lang string
+	// Paging parameters
+	page pagination
+	// Position of the last row served per data type, decoded from the cursor
+	after map[string]database.RowPosition
+	// Delta sync time, zero for a full download
+	since time.Time
+	// Requested fields by data type, nil to serve every column of every data type
+	fields map[string][]string
+	// Row filters, only supported when a single data type is requested
+	filters []database.Filter
+	// Shape the rows are rendered in
+	layout rowLayout
+}
+
+// parseDataRequest reads the query parameters of a language data request.
+// It writes a 400 response and returns false if any of them is invalid.
+func parseDataRequest(c *gin.Context, lang string) (dataRequest, bool) {
+	page, err := parsePagination(c)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return dataRequest{}, false
+	}
+
+	after, err := page.positions()
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return dataRequest{}, false
+	}
+
+	since, err := parseSince(c)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return dataRequest{}, false
+	}
+
+	fields, err := parseFields(c)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return dataRequest{}, false
+	}
+
+	layout, err := parseLayout(c)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return dataRequest{}, false
+	}
+
+	return dataRequest{
+		lang:   lang,
+		page:   page,
+		after:  after,
+		since:  since,
+		fields: fields,
+		layout: layout,
+	}, true
+}
+
+// tableOptions returns the row selection for one data type of the request.
+func (r dataRequest) tableOptions(dataType string) dbqueries.TableOptions {
+	after, resumed := r.after[dataType]
+	return dbqueries.TableOptions{
+		Resumed: resumed,
+		After:   after,
+		Limit:   r.page.limit,
+		Since:   r.since,
+		Columns: r.fields[dataType],
+		Filters: r.filters,
+	}
+}
+
+// requirePaging checks that a data type can be paged through if the request is paged.
+// It writes a 409 response and returns false for tables migrated before paging was supported,
+// as their pages would skip or repeat rows.
+func (r dataRequest) requirePaging(c *gin.Context, dataType string) bool {
+	if !r.page.enabled {
+		return true
+	}
+
+	supported, err := dbqueries.LanguageTableSupportsPaging(r.lang, dataType)
+	if err != nil {
+		log.Printf("Error checking paging support for %s/%s: %v", r.lang, dataType, err)
+		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguageData)
+		return false
+	}
+	if !supported {
+		HandleError(c, http.StatusConflict, constants.PagingUnsupportedError)
+		return false
+	}
+
+	return true
+}
+
+// MARK: Row Layout
+
+// rowLayout is the shape rows of a data type are rendered in.
+type rowLayout string
+
+const (
+	// layoutObjects renders each row as an object mapping column names to values.
+	layoutObjects rowLayout = "objects"
+	// layoutColumnar names the columns once and renders each row as a list of values, see models.ColumnarRows.
+	layoutColumnar rowLayout = "columnar"
+)
+
+// parseLayout reads the optional layout query parameter, defaulting to layoutObjects.
+func parseLayout(c *gin.Context) (rowLayout, error) {
+	switch layout := rowLayout(c.DefaultQuery("layout", string(layoutObjects))); layout {
+	case layoutObjects, layoutColumnar:
+		return layout, nil
+	default:
+		return "", errors.New(constants.InvalidLayoutError)
+	}
+}
+
+// MARK: Delta Sync
+
+// sinceLayouts are the accepted formats of the since query parameter, from most to least precise.
+var sinceLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	constants.DateFormat,
+}
+
+// parseSince reads the optional since query parameter used for delta sync.
+// A zero time is returned when the parameter is absent.
+func parseSince(c *gin.Context) (time.Time, error) {
+	return parseTimeParam(c, "since", constants.InvalidSinceError)
+}
+
+// parseTimeParam reads an optional timestamp query parameter in any of the sinceLayouts.
+// A zero time is returned when the parameter is absent, and an error with errMessage if it cannot be parsed.
+func parseTimeParam(c *gin.Context, name, errMessage string) (time.Time, error) {
+	param := c.Query(name)
+	if param == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range sinceLayouts {
+		if t, err := time.Parse(layout, param); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New(errMessage)
+}
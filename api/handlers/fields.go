@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/dbqueries"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+)
+
+// MARK: Field Projection
+
+// parseFields reads the optional fields query parameter, e.g. nouns.singular,verbs.infinitive.
+// It returns the requested columns by data type, or nil when the parameter is absent.
+func parseFields(c *gin.Context) (map[string][]string, error) {
+	fieldsParam := c.Query("fields")
+	if fieldsParam == "" {
+		return nil, nil
+	}
+
+	fields := make(map[string][]string)
+	for _, field := range strings.Split(fieldsParam, ",") {
+		dataType, column, ok := strings.Cut(strings.TrimSpace(field), ".")
+		if !ok || !database.IsValidColumnName(dataType) || !database.IsValidColumnName(column) {
+			return nil, errors.New(constants.InvalidFieldsError)
+		}
+		if !slices.Contains(fields[dataType], column) {
+			fields[dataType] = append(fields[dataType], column)
+		}
+	}
+
+	return fields, nil
+}
+
+// selects reports whether a data type is part of the response given the requested fields.
+func (r dataRequest) selects(dataType string) bool {
+	return r.fields == nil || r.fields[dataType] != nil
+}
+
+// checkFields verifies that the requested data types exist for the language.
+func (r dataRequest) checkFields(dataTypes []string) error {
+	for dataType := range r.fields {
+		if !slices.Contains(dataTypes, dataType) {
+			return fmt.Errorf("data type '%s' not available for language '%s'", dataType, r.lang)
+		}
+	}
+	return nil
+}
+
+// requireFields checks that the requested fields belong to the given data types.
+// It writes a 400 response and returns false if they do not.
+func (r dataRequest) requireFields(c *gin.Context, dataTypes []string) bool {
+	if err := r.checkFields(dataTypes); err != nil {
+		HandleError(c, http.StatusBadRequest, fmt.Sprintf("Invalid fields: %v", err))
+		return false
+	}
+	return true
+}
+
+// project narrows the schema of a data type to the requested fields, or returns it unchanged if none were requested.
+// It writes the error response and returns false if the fields cannot be served.
+func (r dataRequest) project(c *gin.Context, schema map[string]string, dataType string) (map[string]string, bool) {
+	if r.fields == nil {
+		return schema, true
+	}
+
+	keyColumn, err := dbqueries.GetLanguageTableKeyColumn(r.lang, dataType)
+	if err != nil {
+		log.Printf("Error fetching key column for %s/%s: %v", r.lang, dataType, err)
+		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguageData)
+		return nil, false
+	}
+
+	projected, err := projectSchema(schema, keyColumn, dataType, r.fields[dataType])
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, fmt.Sprintf("Invalid fields: %v", err))
+		return nil, false
+	}
+	return projected, true
+}
+
+// projectSchema validates the requested columns of a data type against its table schema and narrows the schema to them.
+// The key column is always kept, as rows are identified and paged by it.
+func projectSchema(schema map[string]string, keyColumn, dataType string, columns []string) (map[string]string, error) {
+	projected := make(map[string]string, len(columns)+1)
+	if columnType, ok := schema[keyColumn]; ok {
+		projected[keyColumn] = columnType
+	}
+
+	for _, column := range columns {
+		columnType, ok := schema[column]
+		if !ok {
+			return nil, fmt.Errorf("field '%s.%s' does not exist", dataType, column)
+		}
+		projected[column] = columnType
+	}
+
+	return projected, nil
+}
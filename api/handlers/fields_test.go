@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// MARK: Field Projection
+
+func TestGetLanguageDataTypeProjectsFields(t *testing.T) {
+	useTestDB(t, append(dataTestTables,
+		"CREATE TABLE DELanguageDataPrepositionsScribe (preposition VARCHAR(64), grammaticalCase TEXT, frequency INTEGER)",
+		"INSERT INTO DELanguageDataPrepositionsScribe VALUES ('mit', 'dative', 1)",
+	)...)
+
+	tests := []struct {
+		name     string
+		dataType string
+		fields   string
+		want     map[string]string
+		wantRow  map[string]any
+	}{
+		{
+			name:     "lexeme ID kept",
+			dataType: "nouns",
+			fields:   "nouns.plural",
+			want:     map[string]string{"lexemeID": "varchar(64)", "plural": "text"},
+			wantRow:  map[string]any{"lexemeID": "L1", "plural": "Häuser"},
+		},
+		{
+			name:     "key column kept without lexeme ID",
+			dataType: "prepositions",
+			fields:   "prepositions.grammaticalCase",
+			want:     map[string]string{"preposition": "varchar(64)", "grammaticalCase": "text"},
+			wantRow:  map[string]any{"preposition": "mit", "grammaticalCase": "dative"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := gin.Params{{Key: "lang", Value: "de"}, {Key: "dataType", Value: tt.dataType}}
+			w := serveTestRequest(GetLanguageDataType, params, "fields="+tt.fields, "")
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, body %s", w.Code, w.Body)
+			}
+
+			var response models.LanguageDataTypeResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+			if got := response.Contract.Fields[tt.dataType]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("contract fields = %v, want %v", got, tt.want)
+			}
+
+			rows, _ := response.Data.([]any)
+			if len(rows) == 0 || !reflect.DeepEqual(rows[0], tt.wantRow) {
+				t.Errorf("rows = %v, want %v first", rows, tt.wantRow)
+			}
+		})
+	}
+}
+
+func TestGetLanguageDataTypeRejectsUnknownFields(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	for _, fields := range []string{"nouns.genitive", "verbs.infinitive", "nouns.singular,nouns.rowID"} {
+		t.Run(fields, func(t *testing.T) {
+			params := gin.Params{{Key: "lang", Value: "de"}, {Key: "dataType", Value: "nouns"}}
+			if w := serveTestRequest(GetLanguageDataType, params, "fields="+fields, ""); w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want 400, body %s", w.Code, w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+)
+
+// MARK: Row Filters
+
+// reservedParams are the query parameters of data requests that are not row filters.
+var reservedParams = []string{"limit", "cursor", "since", "fields", "format", "layout"}
+
+// filterSuffixes maps the parameter suffixes of row filters to their operators.
+// A parameter without a suffix compares the column for equality, e.g.:
+//
+//	gender=feminine         eq:      the column equals the value
+//	singular_prefix=Ha      prefix:  the column starts with the value
+//	gender_in=feminine,neuter  in:   the column equals one of the comma-separated values
+//	plural_is_null=true     is_null: the column is NULL, or is not NULL for false
+var filterSuffixes = []struct {
+	suffix   string
+	operator database.FilterOperator
+}{
+	{"_is_null", database.FilterIsNull},
+	{"_prefix", database.FilterPrefix},
+	{"_in", database.FilterIn},
+}
+
+// parseFilters reads the row filters of a data type request from its query parameters.
+// A filter parameter names a column of the schema, optionally followed by an operator suffix.
+// Parameters that are neither reserved nor name a column, such as cache busters, are ignored on every data route.
+func parseFilters(c *gin.Context, schema map[string]string) ([]database.Filter, error) {
+	query := c.Request.URL.Query()
+
+	params := make([]string, 0, len(query))
+	for param := range query {
+		if !slices.Contains(reservedParams, param) {
+			params = append(params, param)
+		}
+	}
+	slices.Sort(params)
+
+	filters := make([]database.Filter, 0, len(params))
+	for _, param := range params {
+		filter, ok := filterOf(param, schema)
+		if !ok {
+			continue
+		}
+
+		values := query[param]
+		if len(values) != 1 {
+			return nil, fmt.Errorf("parameter '%s' is given more than once", param)
+		}
+
+		filter, err := parseFilterValue(filter, param, values[0])
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// filterOf resolves the column and operator a query parameter filters by.
+// It returns false if the parameter names no column of the schema.
+func filterOf(param string, schema map[string]string) (database.Filter, bool) {
+	filter := database.Filter{Column: param, Operator: database.FilterEq}
+
+	if _, ok := schema[param]; !ok {
+		for _, s := range filterSuffixes {
+			if column, found := strings.CutSuffix(param, s.suffix); found {
+				filter.Column = column
+				filter.Operator = s.operator
+				break
+			}
+		}
+	}
+
+	_, ok := schema[filter.Column]
+	return filter, ok
+}
+
+// parseFilterValue reads the value of a filter parameter according to the filter's operator.
+func parseFilterValue(filter database.Filter, param, value string) (database.Filter, error) {
+	filter.Values = []string{value}
+
+	switch filter.Operator {
+	case database.FilterIn:
+		filter.Values = strings.Split(value, ",")
+		if len(filter.Values) > constants.MaxFilterValues {
+			return database.Filter{}, fmt.Errorf("'%s' accepts at most %d values", param, constants.MaxFilterValues)
+		}
+
+	case database.FilterIsNull:
+		isNull, err := strconv.ParseBool(value)
+		if err != nil {
+			return database.Filter{}, fmt.Errorf("'%s' must be true or false", param)
+		}
+		filter.Values = []string{strconv.FormatBool(isNull)}
+
+	case database.FilterPrefix:
+		if value == "" {
+			return database.Filter{}, fmt.Errorf("'%s' must not be empty", param)
+		}
+	}
+
+	return filter, nil
+}
+
+// hasFilters reports whether the query holds parameters filtering by a column of the schema.
+// Routes serving several data types do not filter rows, and reject such parameters rather than ignore them.
+func hasFilters(c *gin.Context, schema map[string]string) bool {
+	for param := range c.Request.URL.Query() {
+		if _, ok := filterOf(param, schema); ok && !slices.Contains(reservedParams, param) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/scribe-org/scribe-server/database"
+)
+
+// filterTestSchema is the schema of a noun table with a column whose name ends like an operator suffix.
+var filterTestSchema = map[string]string{
+	"lexemeID":  "varchar",
+	"singular":  "text",
+	"plural":    "text",
+	"gender":    "text",
+	"is_plural": "text",
+	"word_in":   "text",
+}
+
+func TestParseFilters(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []database.Filter
+	}{
+		{"", []database.Filter{}},
+		{"limit=5&format=csv&layout=columnar&fields=singular", []database.Filter{}},
+		{"_=1700000000&utm_source=app&genitive=x&gender_like=x", []database.Filter{}},
+		{"gender=feminine&_=1&_=2", []database.Filter{{Column: "gender", Operator: database.FilterEq, Values: []string{"feminine"}}}},
+		{"gender=feminine", []database.Filter{{Column: "gender", Operator: database.FilterEq, Values: []string{"feminine"}}}},
+		{"singular_prefix=Ha", []database.Filter{{Column: "singular", Operator: database.FilterPrefix, Values: []string{"Ha"}}}},
+		{"gender_in=feminine,neuter", []database.Filter{{Column: "gender", Operator: database.FilterIn, Values: []string{"feminine", "neuter"}}}},
+		{"plural_is_null=1", []database.Filter{{Column: "plural", Operator: database.FilterIsNull, Values: []string{"true"}}}},
+		{"word_in=x", []database.Filter{{Column: "word_in", Operator: database.FilterEq, Values: []string{"x"}}}},
+		{"is_plural_is_null=false", []database.Filter{{Column: "is_plural", Operator: database.FilterIsNull, Values: []string{"false"}}}},
+		{"singular=Haus&gender=neuter", []database.Filter{
+			{Column: "gender", Operator: database.FilterEq, Values: []string{"neuter"}},
+			{Column: "singular", Operator: database.FilterEq, Values: []string{"Haus"}},
+		}},
+	}
+
+	for _, tt := range tests {
+		c, _ := newTestContext(tt.query, "")
+		got, err := parseFilters(c, filterTestSchema)
+		if err != nil {
+			t.Errorf("parseFilters(%q): %v", tt.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseFilters(%q) = %+v, want %+v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestParseFiltersRejectsInvalidParameters(t *testing.T) {
+	tests := []struct {
+		query   string
+		wantErr string
+	}{
+		{"gender=a&gender=b", "more than once"},
+		{"plural_is_null=maybe", "must be true or false"},
+		{"singular_prefix=", "must not be empty"},
+		{"gender_in=" + strings.Repeat("x,", 100) + "x", "at most 100 values"},
+	}
+
+	for _, tt := range tests {
+		c, _ := newTestContext(tt.query, "")
+		if filters, err := parseFilters(c, filterTestSchema); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("parseFilters(%q) = %+v, %v, want error containing %q", tt.query, filters, err, tt.wantErr)
+		}
+	}
+}
+
+func TestHasFilters(t *testing.T) {
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"limit=5&fields=nouns.singular", false},
+		{"_=1700000000&utm_source=app&gender_like=x", false},
+		{url.Values{"gender` = gender OR 1=1 --": {"x"}}.Encode(), false},
+		{"gender=feminine", true},
+		{"singular_prefix=Ha", true},
+		{"plural_is_null=maybe", true},
+	}
+
+	for _, tt := range tests {
+		c, _ := newTestContext(tt.query, "")
+		if got := hasFilters(c, filterTestSchema); got != tt.want {
+			t.Errorf("hasFilters(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
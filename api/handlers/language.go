@@ -7,8 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"os"
-	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,9 +16,9 @@ import (
 	"github.com/scribe-org/scribe-server/api/validators"
 	"github.com/scribe-org/scribe-server/database"
 	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/internal/languages"
 	"github.com/scribe-org/scribe-server/models"
-	"github.com/spf13/viper"
-	"gopkg.in/yaml.v3"
+	"golang.org/x/text/language"
 )
 
 // MARK: Languages Endpoints
@@ -27,23 +26,27 @@ import (
 // GetAvailableLanguages returns a list of all supported languages and their available data types.
 //
 // @Summary List all supported languages
-// @Description Fetches all languages currently supported by Scribe, along with their associated data types.
+// @Description Fetches all languages currently supported by Scribe, along with their associated data types,
+// @Description English and native names, script, text direction and Wikidata item. Display names follow Accept-Language.
 // @Tags Languages
 // @Accept  json
 // @Produce  json
+// @Param Accept-Language header string false "Languages to give display names in (e.g., "de-CH, de;q=0.9")"
 // @Success 200 {object} models.AvailableLanguagesResponse "Successfully retrieved available languages"
 // @Failure 500 {object} models.ErrorResponse "Internal server error occurred while fetching languages"
 // @Router /api/v1/languages [get]
 func GetAvailableLanguages(c *gin.Context) {
-	languages, err := database.GetAvailableLanguages()
+	availableLanguages, err := database.GetAvailableLanguages()
 	if err != nil {
 		log.Printf("Error fetching available languages: %v", err)
 		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguages)
 		return
 	}
 
+	displayIn := displayLanguage(c)
+
 	var languageInfos []models.LanguageInfo
-	for _, lang := range languages {
+	for _, lang := range availableLanguages {
 		dataTypes, err := database.GetLanguageDataTypes(lang)
 		if err != nil {
 			log.Printf("Error fetching data types for %s: %v", lang, err)
@@ -51,8 +54,9 @@ func GetAvailableLanguages(c *gin.Context) {
 		}
 
 		languageInfos = append(languageInfos, models.LanguageInfo{
-			Code:      lang,
-			DataTypes: dataTypes,
+			Code:             lang,
+			LanguageMetadata: languages.Metadata(lang, displayIn),
+			DataTypes:        dataTypes,
 		})
 	}
 
@@ -66,35 +70,51 @@ func GetAvailableLanguages(c *gin.Context) {
 // GetLanguageData returns the full dataset and schema contract for a specific language.
 //
 // @Summary Retrieve full language data
-// @Description Returns all available language data and schema contract for the given ISO 639-1 language code.
+// @Description Returns all available language data and schema contract for the given ISO 639-1 or ISO 639-3 language code.
+// @Description Rows cannot be filtered here: parameters naming a column are rejected, use /api/v1/data/{lang}/{dataType} instead.
+// @Description Other unknown query parameters, such as cache busters, are ignored.
 // @Tags Language Data
 // @Accept  json
-// @Produce  json
-// @Param lang path string true "Language code (ISO 639-1)" example(en)
+// @Produce  json,application/x-yaml,application/x-ndjson,application/msgpack,application/cbor
+// @Param lang path string true "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(en)
+// @Param limit query int false "Maximum number of rows per data type; enables pagination" minimum(1) maximum(10000)
+// @Param cursor query string false "Cursor from the next_cursor field of the previous page"
+// @Param since query string false "Only return rows modified or migrated after this RFC 3339 timestamp or date, plus the lexeme IDs deleted since" example(2025-01-31T12:00:00Z)
+// @Param fields query string false "Comma-separated data type and field pairs to return; other data types and fields are left out" example(nouns.singular,nouns.plural,verbs.infinitive)
+// @Param format query string false "Response format, overriding the Accept header" Enums(json, yaml, ndjson, msgpack, cbor)
+// @Param layout query string false "Rows as objects, or as column names followed by lists of values (JSON, YAML, MessagePack and CBOR)" Enums(objects, columnar)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
 // @Success 200 {object} models.LanguageDataResponse "Successfully retrieved language data"
-// @Failure 400 {object} models.ErrorResponse "Invalid or malformed language code"
+// @Header 200 {string} X-Next-Cursor "Cursor to the next page in NDJSON and CSV responses"
+// @Header 200 {string} Content-Language "Language code the data is served in, e.g. pt for pt-BR"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the underlying data last changed"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid or malformed language code, limit, cursor, since, fields, layout or format, or a row filter"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
 // @Failure 404 {object} models.ErrorResponse "Requested language not found or unsupported"
+// @Failure 409 {object} models.ErrorResponse "Pagination requested for data migrated before it was supported"
 // @Failure 500 {object} models.ErrorResponse "Internal server error while fetching data"
 // @Router /api/v1/data/{lang} [get]
 func GetLanguageData(c *gin.Context) {
-	lang := c.Param("lang")
+	lang, ok := requireLanguage(c, c.Param("lang"))
+	if !ok {
+		return
+	}
 
-	// Validate language code.
-	if !validators.IsValidLanguageCode(lang) {
-		HandleError(c, http.StatusBadRequest, constants.InvalidLanguageCodeError)
+	req, ok := parseDataRequest(c, lang)
+	if !ok {
 		return
 	}
 
-	// Check if language exists in database.
-	availableLanguages, err := database.GetAvailableLanguages()
-	if err != nil {
-		log.Printf("Error checking available languages: %v", err)
-		HandleError(c, http.StatusInternalServerError, "Failed to check language availability")
+	format, ok := negotiateFormat(c, documentFormats)
+	if !ok {
 		return
 	}
 
-	if !validators.IsLanguageSupported(lang, availableLanguages) {
-		HandleError(c, http.StatusNotFound, fmt.Sprintf("Language '%s' not supported", lang))
+	updatedAt, notModified := checkLanguageNotModified(c, lang)
+	if notModified {
 		return
 	}
 
@@ -106,42 +126,173 @@ func GetLanguageData(c *gin.Context) {
 		return
 	}
 
-	// Build the response.
-	response := models.LanguageDataResponse{
-		Language: lang,
-		Contract: models.Contract{
-			Version:   constants.APIVersion,
-			UpdatedAt: time.Now().Format(constants.DateFormat),
-			Fields:    make(map[string]map[string]string),
-		},
-		Data: make(map[string]any),
+	if !req.requireFields(c, dataTypes) {
+		return
 	}
 
-	// For each data type, get schema and data.
+	contract := models.Contract{
+		Version:   constants.APIVersion,
+		UpdatedAt: formatUpdatedAt(updatedAt),
+		Fields:    make(map[string]map[string]string),
+	}
+
+	// The contract precedes the data in the response, so collect every schema up front.
+	var pageDataTypes []string
 	for _, dataType := range dataTypes {
-		// Sanitize inputs before constructing table name.
-		sanitizedLang := validators.SanitizeLanguageCode(lang)
-		if sanitizedLang == "" {
-			log.Printf("Failed to sanitize language code: %s", lang)
+		if !req.selects(dataType) || (req.page.enabled && !req.page.includes(dataType)) {
 			continue
 		}
 
-		tableData, err := dbqueries.GetLanguageTableData(lang, dataType)
+		schema, err := dbqueries.GetLanguageTableSchema(lang, dataType)
 		if err != nil {
-			log.Printf("Error fetching table data for %s/%s: %v", lang, dataType, err)
+			log.Printf("Error fetching schema for %s/%s: %v", lang, dataType, err)
 			continue
 		}
+		if hasFilters(c, schema) {
+			HandleError(c, http.StatusBadRequest, constants.FiltersUnsupportedError)
+			return
+		}
+		if !req.requirePaging(c, dataType) {
+			return
+		}
 
-		// Add to response.
-		if schema, ok := tableData["schema"].(map[string]string); ok {
-			response.Contract.Fields[dataType] = schema
+		if schema, ok = req.project(c, schema, dataType); !ok {
+			return
 		}
-		if data, ok := tableData["data"]; ok {
-			response.Data[dataType] = data
+
+		contract.Fields[dataType] = schema
+		pageDataTypes = append(pageDataTypes, dataType)
+	}
+
+	switch format {
+	case formatJSON:
+		streamLanguageData(c, req, contract, pageDataTypes)
+	case formatNDJSON:
+		streamLanguageDataRecords(c, req, pageDataTypes)
+	default:
+		response, err := collectLanguageData(req, contract, pageDataTypes)
+		if err != nil {
+			log.Printf("Error collecting language data for %s: %v", lang, err)
+			HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguageData)
+			return
 		}
+		renderResponse(c, format, response, nil)
 	}
+}
 
-	HandleSuccess(c, response)
+// GetLanguageDataType returns the schema contract and rows for a single data type of a language.
+//
+// @Summary Retrieve data for one data type of a language
+// @Description Returns the contract fields and rows of one data type (e.g. nouns, verbs) for the given ISO 639-1 or ISO 639-3 language code.
+// @Description Rows can be filtered by any column with further query parameters: `column=value` (eq), `column_prefix=value` (prefix),
+// @Description `column_in=a,b` (in) and `column_is_null=true|false` (is_null), e.g. `?gender=feminine&singular_prefix=Ha`.
+// @Description Query parameters that name no column, such as cache busters, are ignored.
+// @Tags Language Data
+// @Accept  json
+// @Produce  json,application/x-yaml,application/x-ndjson,text/csv,application/msgpack,application/cbor
+// @Param lang path string true "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(de)
+// @Param dataType path string true "Data type as listed by /api/v1/languages" example(verbs)
+// @Param limit query int false "Maximum number of rows; enables pagination" minimum(1) maximum(10000)
+// @Param cursor query string false "Cursor from the next_cursor field of the previous page"
+// @Param since query string false "Only return rows modified or migrated after this RFC 3339 timestamp or date, plus the lexeme IDs deleted since" example(2025-01-31T12:00:00Z)
+// @Param fields query string false "Comma-separated data type and field pairs to return; other data types and fields are left out" example(nouns.singular,nouns.plural,verbs.infinitive)
+// @Param format query string false "Response format, overriding the Accept header" Enums(json, yaml, ndjson, csv, msgpack, cbor)
+// @Param layout query string false "Rows as objects, or as column names followed by lists of values (JSON, YAML, MessagePack and CBOR)" Enums(objects, columnar)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
+// @Success 200 {object} models.LanguageDataTypeResponse "Successfully retrieved data type"
+// @Header 200 {string} X-Next-Cursor "Cursor to the next page in NDJSON and CSV responses"
+// @Header 200 {string} Content-Language "Language code the data is served in, e.g. pt for pt-BR"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the underlying data last changed"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid or malformed language code, limit, cursor, since, fields, filter, layout or format"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
+// @Failure 404 {object} models.ErrorResponse "Language or data type not found"
+// @Failure 409 {object} models.ErrorResponse "Pagination requested for data migrated before it was supported"
+// @Failure 500 {object} models.ErrorResponse "Internal server error while fetching data"
+// @Router /api/v1/data/{lang}/{dataType} [get]
+func GetLanguageDataType(c *gin.Context) {
+	dataType := c.Param("dataType")
+
+	lang, ok := requireLanguage(c, c.Param("lang"))
+	if !ok || !requireDataType(c, lang, dataType) {
+		return
+	}
+
+	req, ok := parseDataRequest(c, lang)
+	if !ok {
+		return
+	}
+	if req.page.enabled && !req.page.includes(dataType) {
+		HandleError(c, http.StatusBadRequest, constants.InvalidCursorError)
+		return
+	}
+
+	format, ok := negotiateFormat(c, tableFormats)
+	if !ok {
+		return
+	}
+
+	if !req.requireFields(c, []string{dataType}) {
+		return
+	}
+
+	updatedAt, notModified := checkLanguageNotModified(c, lang)
+	if notModified {
+		return
+	}
+
+	schema, err := dbqueries.GetLanguageTableSchema(lang, dataType)
+	if err != nil {
+		log.Printf("Error fetching schema for %s/%s: %v", lang, dataType, err)
+		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguageData)
+		return
+	}
+
+	// Filters may reference any column, so they are read before the schema is projected.
+	req.filters, err = parseFilters(c, schema)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, fmt.Sprintf("Invalid filter: %v", err))
+		return
+	}
+
+	if schema, ok = req.project(c, schema, dataType); !ok {
+		return
+	}
+
+	if !req.requirePaging(c, dataType) {
+		return
+	}
+
+	rows, err := dbqueries.OpenLanguageTableRows(lang, dataType, req.tableOptions(dataType))
+	if err != nil {
+		log.Printf("Error fetching table data for %s/%s: %v", lang, dataType, err)
+		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguageData)
+		return
+	}
+	defer rows.Close()
+
+	contract := models.Contract{
+		Version:   constants.APIVersion,
+		UpdatedAt: formatUpdatedAt(updatedAt),
+		Fields:    map[string]map[string]string{dataType: schema},
+	}
+
+	switch format {
+	case formatJSON:
+		streamLanguageDataType(c, req, contract, dataType, rows)
+	case formatNDJSON, formatCSV:
+		streamLanguageDataTypeRecords(c, req, format, dataType, rows)
+	default:
+		response, err := collectLanguageDataType(req, contract, dataType, rows)
+		if err != nil {
+			log.Printf("Error reading table data for %s/%s: %v", lang, dataType, err)
+			HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguageData)
+			return
+		}
+		renderResponse(c, format, response, nil)
+	}
 }
 
 // MARK: Language Version Info
@@ -153,31 +304,24 @@ func GetLanguageData(c *gin.Context) {
 // @Tags Language Data
 // @Accept  json
 // @Produce  json
-// @Param lang path string true "Language code (ISO 639-1)" example(fr)
+// @Param lang path string true "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(fr)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
 // @Success 200 {object} models.LanguageVersionResponse "Successfully retrieved language version details"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the underlying data last changed"
+// @Success 304 "Cached copy is still current"
 // @Failure 400 {object} models.ErrorResponse "Invalid language code"
 // @Failure 404 {object} models.ErrorResponse "Language not found"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /api/v1/data-version/{lang} [get]
 func GetLanguageVersion(c *gin.Context) {
-	lang := c.Param("lang")
-
-	// Validate language code.
-	if !validators.IsValidLanguageCode(lang) {
-		HandleError(c, http.StatusBadRequest, constants.InvalidLanguageCodeError)
+	lang, ok := requireLanguage(c, c.Param("lang"))
+	if !ok {
 		return
 	}
 
-	// Check if language exists in database.
-	availableLanguages, err := database.GetAvailableLanguages()
-	if err != nil {
-		log.Printf("Error checking available languages: %v", err)
-		HandleError(c, http.StatusInternalServerError, "Failed to check language availability")
-		return
-	}
-
-	if !validators.IsLanguageSupported(lang, availableLanguages) {
-		HandleError(c, http.StatusNotFound, fmt.Sprintf("Language '%s' not supported", lang))
+	if _, notModified := checkLanguageNotModified(c, lang); notModified {
 		return
 	}
 
@@ -197,177 +341,139 @@ func GetLanguageVersion(c *gin.Context) {
 	HandleSuccess(c, response)
 }
 
-// MARK: Contracts Retrieval
-
-// GetContracts returns schema contracts for all languages or a specific one if a query parameter is provided.
-//
-// @Summary Retrieve schema contracts
-// @Description If a 'lang' query parameter is provided, returns the contract for that specific language. Otherwise, returns all contracts.
-// @Tags Contracts
-// @Accept  json
-// @Produce  json
-// @Param lang query string false "Language code (ISO 639-1)" example(es)
-// @Success 200 {object} models.ContractsResponse "Successfully retrieved contracts"
-// @Failure 400 {object} models.ErrorResponse "Invalid language code provided"
-// @Failure 404 {object} models.ErrorResponse "Language not supported"
-// @Failure 500 {object} models.ErrorResponse "Failed to load contract files"
-// @Router /api/v1/contracts [get]
-func GetContracts(c *gin.Context) {
-	lang := c.Query("lang")
-	contractsDir := viper.GetString("contractsDir")
-
-	if lang != "" {
-		if !validators.IsValidLanguageCode(lang) {
-			HandleError(c, http.StatusBadRequest, constants.InvalidLanguageCodeError)
-			return
-		}
-
-		availableLanguages, err := database.GetAvailableLanguages()
-		if err != nil {
-			log.Printf("Error checking available languages: %v", err)
-			HandleError(c, http.StatusInternalServerError, "Failed to check language availability")
-			return
-		}
-
-		if !validators.IsLanguageSupported(lang, availableLanguages) {
-			HandleError(c, http.StatusNotFound, fmt.Sprintf("Language '%s' not supported", lang))
-			return
-		}
-	}
-
-	var contracts map[string]any
-	var err error
-
-	if lang != "" {
-		// Load a single language.
-		contracts, err = loadSingleContract(contractsDir, lang)
-	} else {
-		// Load all languages.
-		contracts, err = loadAllContracts(contractsDir)
-	}
-
-	if err != nil {
-		log.Printf("Error loading contracts: %v", err)
-		HandleError(c, http.StatusInternalServerError, "Failed to load contracts")
-		return
-	}
-
-	HandleYAMLSuccess(c, gin.H{
-		"contracts": contracts,
-	})
-}
-
 // MARK: Translation Data Retrieval
 
 // GetTranslationData returns translation data from a source language into a target language.
 //
 // @Summary Retrieve translation data
 // @Description Returns nested translation data for the given target and source language ISO codes.
+// @Description Pages hold whole source words. With since set, an empty page is returned unless the data was migrated after it
+// @Description or its migration time is unknown.
+// @Description With pivot set, missing language pairs are composed from two tables through an intermediate language and their entries marked as pivoted.
 // @Tags Translations
 // @Accept  json
-// @Produce  json
-// @Param source_lang path string true "Source language code (ISO 639-1)" example(de)
-// @Param target_lang path string true "Target language code (ISO 639-1)" example(bn)
+// @Produce  json,application/x-yaml,application/x-ndjson,text/csv,application/msgpack,application/cbor
+// @Param source_lang path string true "Source language code (ISO 639-1 or ISO 639-3)" example(de)
+// @Param target_lang path string true "Target language code (ISO 639-1 or ISO 639-3)" example(bn)
+// @Param pivot query string false "If no direct translation data exists, compose it through this language, or 'auto' to pick one" example(en)
+// @Param wordType query string false "Only return entries of this word type" example(noun)
+// @Param limit query int false "Maximum number of source words; enables pagination" minimum(1) maximum(10000)
+// @Param cursor query string false "Cursor from the next_cursor field of the previous page"
+// @Param since query string false "updated_at of a cached copy; data is only returned if it was migrated again since" example(2025-01-31T12:00:00Z)
+// @Param format query string false "Response format, overriding the Accept header" Enums(json, yaml, ndjson, csv, msgpack, cbor)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
 // @Success 200 {object} models.TranslationDataResponse "Successfully retrieved translation data"
-// @Failure 400 {object} models.ErrorResponse "Invalid language code"
+// @Header 200 {string} X-Next-Cursor "Cursor to the next page in NDJSON and CSV responses"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the translation data was last migrated"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid language code, pivot, word type, limit, cursor, format or since"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
 // @Failure 404 {object} models.ErrorResponse "Translation data not found"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /api/v1/translations [get]
 func GetTranslationData(c *gin.Context) {
-	sourceLang := c.Query("source_lang")
-	targetLang := c.Query("target_lang")
+	req, ok := parseTranslationRequest(c)
+	if !ok {
+		return
+	}
 
-	if sourceLang == "" || targetLang == "" {
-		HandleError(c, http.StatusBadRequest, constants.EmptyTranslationCodeError)
+	format, ok := negotiateFormat(c, tableFormats)
+	if !ok {
 		return
 	}
 
-	if !validators.IsValidTranslationLangCode(targetLang) || !validators.IsValidTranslationLangCode(sourceLang) {
-		HandleError(c, http.StatusBadRequest, constants.InvalidTranslationLangCodeError)
+	pivotLang, migratedAt, err := resolveTranslationSource(req)
+	if err == nil && checkTranslationNotModified(c, migratedAt) {
+		return
+	}
+	if err == nil && !req.since.IsZero() && !migratedAt.IsZero() && !migratedAt.After(req.since) {
+		// Nothing changed since the client's copy was migrated. An unknown migration time counts as changed.
+		renderTranslationData(c, format, req.response(dbqueries.TranslationData{}, pivotLang, migratedAt, ""))
 		return
 	}
 
-	data, err := dbqueries.GetTranslationTableData(targetLang, sourceLang)
+	var data dbqueries.TranslationData
+	var nextWord string
+	if err == nil && pivotLang != "" {
+		data, nextWord, err = dbqueries.GetPivotTranslationTableData(req.targetLang, req.sourceLang, pivotLang, req.options())
+	} else if err == nil {
+		data, nextWord, err = dbqueries.GetTranslationTableData(req.targetLang, req.sourceLang, req.options())
+	}
+
 	if err != nil {
-		log.Printf("Error fetching translation data for %s/%s: %v", targetLang, sourceLang, err)
+		log.Printf("Error fetching translation data for %s/%s: %v", req.targetLang, req.sourceLang, err)
 		if strings.Contains(err.Error(), "does not exist") {
-			HandleError(c, http.StatusNotFound, fmt.Sprintf("No translation data for '%s' from '%s'", targetLang, sourceLang))
+			HandleError(c, http.StatusNotFound, fmt.Sprintf("No translation data for '%s' from '%s'", req.targetLang, req.sourceLang))
 			return
 		}
 		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingTranslationData)
 		return
 	}
 
-	HandleSuccess(c, models.TranslationDataResponse{
-		TargetLang: targetLang,
-		SourceLang: sourceLang,
-		Data:       data,
-	})
+	renderTranslationData(c, format, req.response(data, pivotLang, migratedAt, nextWord))
 }
 
-// MARK: Contract Loading Helpers
-
-// loadSingleContract reads and unmarshals a single contract file.
-func loadSingleContract(contractsDir, lang string) (map[string]any, error) {
-	filePathYaml := filepath.Join(contractsDir, lang+".yaml")
-	filePathYml := filepath.Join(contractsDir, lang+".yml")
+// MARK: Request Helpers
 
-	filePath := filePathYaml
-	if _, err := os.Stat(filePathYaml); os.IsNotExist(err) {
-		filePath = filePathYml
+// requireLanguage resolves a language code or BCP-47 tag onto a supported language and checks that data exists for it.
+// The served language is returned and stated in the Content-Language header.
+// It writes the error response and returns false if the request cannot be served.
+func requireLanguage(c *gin.Context, tag string) (string, bool) {
+	// Resolve tags such as pt-BR onto the language code they are served with.
+	lang, ok := validators.ResolveLanguageTag(tag)
+	if !ok {
+		HandleError(c, http.StatusBadRequest, constants.InvalidLanguageCodeError)
+		return "", false
 	}
 
-	data, err := os.ReadFile(filePath)
+	// Check if language exists in database.
+	availableLanguages, err := database.GetAvailableLanguages()
 	if err != nil {
-		return nil, fmt.Errorf("could not read contract file for %s: %w", lang, err)
+		log.Printf("Error checking available languages: %v", err)
+		HandleError(c, http.StatusInternalServerError, "Failed to check language availability")
+		return "", false
 	}
 
-	var contract any
-	if err := yaml.Unmarshal(data, &contract); err != nil {
-		return nil, fmt.Errorf("could not unmarshal contract for %s: %w", lang, err)
+	if !validators.IsLanguageSupported(lang, availableLanguages) {
+		HandleError(c, http.StatusNotFound, fmt.Sprintf("Language '%s' not supported", lang))
+		return "", false
 	}
 
-	contract = normalizeMap(contract)
-
-	return map[string]any{lang: contract}, nil
+	c.Header("Content-Language", lang)
+	return lang, true
 }
 
-// loadAllContracts reads and unmarshals all .json files in a directory.
-func loadAllContracts(contractsDir string) (map[string]any, error) {
-	contracts := make(map[string]any)
+// displayLanguage picks the language to give language names in from the Accept-Language header.
+// Responses naming languages vary by that header, which is stated for caches.
+func displayLanguage(c *gin.Context) language.Tag {
+	c.Writer.Header().Add("Vary", "Accept-Language")
+	return languages.DisplayLanguage(c.GetHeader("Accept-Language"))
+}
 
-	files, err := os.ReadDir(contractsDir)
+// requireDataType checks that a data type exists for an already validated language.
+// It writes the error response and returns false if the request cannot be served.
+func requireDataType(c *gin.Context, lang, dataType string) bool {
+	dataTypes, err := database.GetLanguageDataTypes(lang)
 	if err != nil {
-		return nil, fmt.Errorf("could not read contracts directory: %w", err)
+		log.Printf("Error fetching data types for %s: %v", lang, err)
+		HandleError(c, http.StatusInternalServerError, "Failed to fetch language data types")
+		return false
 	}
 
-	for _, file := range files {
-		if file.IsDir() {
-			continue
-		}
-
-		ext := filepath.Ext(file.Name())
-		if ext != ".yaml" && ext != ".yml" {
-			continue
-		}
-
-		langCode := strings.TrimSuffix(file.Name(), ext)
-		filePath := filepath.Join(contractsDir, file.Name())
-
-		data, err := os.ReadFile(filePath)
-		if err != nil {
-			log.Printf("Warning: could not read contract file %s: %v", file.Name(), err)
-			continue
-		}
+	if !slices.Contains(dataTypes, dataType) {
+		HandleError(c, http.StatusNotFound, fmt.Sprintf("Data type '%s' not available for language '%s'", dataType, lang))
+		return false
+	}
 
-		var contract any
-		if err := yaml.Unmarshal(data, &contract); err != nil {
-			log.Printf("Warning: could not unmarshal contract file %s: %v", file.Name(), err)
-			continue
-		}
+	return true
+}
 
-		contracts[langCode] = normalizeMap(contract)
+// formatUpdatedAt renders when a dataset was last migrated, falling back to today if unknown.
+func formatUpdatedAt(updatedAt time.Time) string {
+	if updatedAt.IsZero() {
+		updatedAt = time.Now()
 	}
-
-	return contracts, nil
+	return updatedAt.Format(constants.DateFormat)
 }
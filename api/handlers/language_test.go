@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/validators"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/testdb"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// useTestDB serves the handlers from an in-memory database prepared by the given statements,
+// supporting the languages of its data tables like the server does at startup.
+func useTestDB(t *testing.T, statements ...string) {
+	t.Helper()
+
+	previous := database.DB
+	database.DB = testdb.Open(t, statements...)
+	t.Cleanup(func() { database.DB = previous })
+
+	langs, err := database.GetAvailableLanguages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validators.InitLanguageValidator(langs)
+}
+
+// dataTestTables holds a German noun and verb table.
+var dataTestTables = []string{
+	"CREATE TABLE DELanguageDataNounsScribe (lexemeID VARCHAR(64), singular TEXT, plural TEXT, count INTEGER)",
+	"INSERT INTO DELanguageDataNounsScribe VALUES ('L1', 'Haus', 'Häuser', 2), ('L2', 'Baum', NULL, 1), ('L3', 'Tür', 'Türen', 3)",
+	"CREATE TABLE DELanguageDataVerbsScribe (lexemeID VARCHAR(64), infinitive TEXT)",
+	"INSERT INTO DELanguageDataVerbsScribe VALUES ('L7', 'gehen')",
+}
+
+// serveTestRequest runs a handler for a GET request with the given path parameters and query.
+func serveTestRequest(handler gin.HandlerFunc, params gin.Params, query, accept string) *httptest.ResponseRecorder {
+	c, w := newTestContext(query, accept)
+	c.Params = params
+	handler(c)
+	return w
+}
+
+// decodeNDJSON decodes the lines of an NDJSON response body.
+func decodeNDJSON(t *testing.T, body string) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		var record map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("decoding NDJSON line %q: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// MARK: Available Languages
+
+func TestGetAvailableLanguagesDisplaysNamesInAcceptLanguage(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{"", "German"},
+		{"de-CH, de;q=0.9", "Deutsch"},
+		{"fr;q=0.5, sv", "tyska"},
+	}
+
+	for _, tt := range tests {
+		c, w := newTestContext("", "")
+		if tt.acceptLanguage != "" {
+			c.Request.Header.Set("Accept-Language", tt.acceptLanguage)
+		}
+		GetAvailableLanguages(c)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Accept-Language %q: status = %d, body %s", tt.acceptLanguage, w.Code, w.Body)
+		}
+
+		var got models.AvailableLanguagesResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Languages) != 1 || got.Languages[0].Code != "de" {
+			t.Fatalf("Accept-Language %q: languages = %+v, want only de", tt.acceptLanguage, got.Languages)
+		}
+		de := got.Languages[0]
+		if de.DisplayName != tt.want || de.EnglishName != "German" || de.NativeName != "Deutsch" {
+			t.Errorf("Accept-Language %q: names = %q, %q, %q, want display name %q",
+				tt.acceptLanguage, de.DisplayName, de.EnglishName, de.NativeName, tt.want)
+		}
+		if de.Direction != "ltr" || de.WikidataQID != "Q188" {
+			t.Errorf("Accept-Language %q: direction %q, QID %q, want ltr, Q188", tt.acceptLanguage, de.Direction, de.WikidataQID)
+		}
+	}
+}
+
+// MARK: Data Type Retrieval
+
+func TestGetLanguageDataType(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	w := serveTestRequest(GetLanguageDataType, gin.Params{{Key: "lang", Value: "de"}, {Key: "dataType", Value: "verbs"}}, "", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+
+	var response models.LanguageDataTypeResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Language != "de" || response.DataType != "verbs" {
+		t.Errorf("response names %s/%s, want de/verbs", response.Language, response.DataType)
+	}
+	if want := map[string]map[string]string{"verbs": {"lexemeID": "varchar(64)", "infinitive": "text"}}; !reflect.DeepEqual(response.Contract.Fields, want) {
+		t.Errorf("contract fields = %v, want %v", response.Contract.Fields, want)
+	}
+	if want := []any{map[string]any{"lexemeID": "L7", "infinitive": "gehen"}}; !reflect.DeepEqual(response.Data, want) {
+		t.Errorf("data = %v, want %v", response.Data, want)
+	}
+}
+
+func TestGetLanguageDataTypeNotFound(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	tests := []struct {
+		lang, dataType string
+		want           int
+	}{
+		{"de", "adjectives", http.StatusNotFound},
+		{"fr", "nouns", http.StatusBadRequest},
+		{"d3", "nouns", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		params := gin.Params{{Key: "lang", Value: tt.lang}, {Key: "dataType", Value: tt.dataType}}
+		if w := serveTestRequest(GetLanguageDataType, params, "", ""); w.Code != tt.want {
+			t.Errorf("/data/%s/%s status = %d, want %d, body %s", tt.lang, tt.dataType, w.Code, tt.want, w.Body)
+		}
+	}
+}
+
+func TestGetLanguageDataTypeResolvesLanguageTags(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	for _, tag := range []string{"de", "de-AT", "de_CH"} {
+		w := serveTestRequest(GetLanguageDataType, gin.Params{{Key: "lang", Value: tag}, {Key: "dataType", Value: "verbs"}}, "", "")
+		if w.Code != http.StatusOK {
+			t.Errorf("/data/%s/verbs status = %d, body %s", tag, w.Code, w.Body)
+			continue
+		}
+		if got := w.Header().Get("Content-Language"); got != "de" {
+			t.Errorf("/data/%s/verbs Content-Language = %q, want de", tag, got)
+		}
+	}
+}
+
+// MARK: Record Streaming
+
+func TestGetLanguageDataStreamsNDJSONRecords(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	w := serveTestRequest(GetLanguageData, gin.Params{{Key: "lang", Value: "de"}}, "format=ndjson", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+	if cursor := w.Header().Get(nextCursorHeader); cursor != "" {
+		t.Errorf("%s = %q without pagination", nextCursorHeader, cursor)
+	}
+
+	want := []map[string]any{
+		{"data_type": "nouns", "lexemeID": "L1", "singular": "Haus", "plural": "Häuser", "count": "2"},
+		{"data_type": "nouns", "lexemeID": "L2", "singular": "Baum", "plural": nil, "count": "1"},
+		{"data_type": "nouns", "lexemeID": "L3", "singular": "Tür", "plural": "Türen", "count": "3"},
+		{"data_type": "verbs", "lexemeID": "L7", "infinitive": "gehen"},
+	}
+	if got := decodeNDJSON(t, w.Body.String()); !reflect.DeepEqual(got, want) {
+		t.Errorf("records = %v, want %v", got, want)
+	}
+}
+
+func TestGetLanguageDataPagesNDJSONRecords(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	var ids []string
+	query := "format=ndjson&limit=2"
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("paging did not end")
+		}
+
+		w := serveTestRequest(GetLanguageData, gin.Params{{Key: "lang", Value: "de"}}, query, "")
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, body %s", w.Code, w.Body)
+		}
+		for _, record := range decodeNDJSON(t, w.Body.String()) {
+			ids = append(ids, record["lexemeID"].(string))
+		}
+
+		cursor := w.Header().Get(nextCursorHeader)
+		if cursor == "" {
+			break
+		}
+		query = "format=ndjson&limit=2&cursor=" + cursor
+	}
+
+	if got, want := strings.Join(ids, ","), "L1,L2,L7,L3"; got != want {
+		t.Errorf("paged through %s, want %s", got, want)
+	}
+}
+
+// MARK: Query Parameters
+
+func TestDataRoutesTreatQueryParametersAlike(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	langParams := gin.Params{{Key: "lang", Value: "de"}}
+	typeParams := gin.Params{{Key: "lang", Value: "de"}, {Key: "dataType", Value: "nouns"}}
+
+	tests := []struct {
+		name      string
+		query     string
+		wantLang  int
+		wantType  int
+		wantNouns int
+	}{
+		{"cache buster", "_=1700000000&utm_source=app", http.StatusOK, http.StatusOK, 3},
+		{"row filter", "singular=Haus", http.StatusBadRequest, http.StatusOK, 1},
+		{"invalid row filter", "plural_is_null=maybe", http.StatusBadRequest, http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := serveTestRequest(GetLanguageData, langParams, tt.query, ""); w.Code != tt.wantLang {
+				t.Errorf("/data/de status = %d, want %d, body %s", w.Code, tt.wantLang, w.Body)
+			}
+
+			w := serveTestRequest(GetLanguageDataType, typeParams, tt.query+"&format=ndjson", "")
+			if w.Code != tt.wantType {
+				t.Fatalf("/data/de/nouns status = %d, want %d, body %s", w.Code, tt.wantType, w.Body)
+			}
+			if w.Code == http.StatusOK {
+				if got := len(decodeNDJSON(t, w.Body.String())); got != tt.wantNouns {
+					t.Errorf("/data/de/nouns served %d rows, want %d", got, tt.wantNouns)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/dbqueries"
+	"github.com/scribe-org/scribe-server/api/validators"
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// MARK: Lexeme Lookup
+
+// GetLexeme returns the rows carrying a Wikidata lexeme ID across all language data and translation tables.
+//
+// @Summary Resolve a Wikidata lexeme ID
+// @Description Finds the rows for a Wikidata lexeme ID in every language data and translation table and returns them with their language, data type and contract fields.
+// @Tags Language Data
+// @Accept  json
+// @Produce  json
+// @Param lexemeID path string true "Wikidata lexeme ID" example(L9837)
+// @Success 200 {object} models.LexemeResponse "Successfully resolved the lexeme ID"
+// @Failure 400 {object} models.ErrorResponse "Invalid lexeme ID"
+// @Failure 404 {object} models.ErrorResponse "Lexeme not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error while searching data"
+// @Router /api/v1/lexemes/{lexemeID} [get]
+func GetLexeme(c *gin.Context) {
+	lexemeID := c.Param("lexemeID")
+
+	if !validators.IsValidLexemeID(lexemeID) {
+		HandleError(c, http.StatusBadRequest, constants.InvalidLexemeIDError)
+		return
+	}
+
+	matches, err := dbqueries.FindLexemeRows(lexemeID, constants.MaxLookupRows)
+	if err != nil {
+		log.Printf("Error searching for lexeme %s: %v", lexemeID, err)
+		HandleError(c, http.StatusInternalServerError, constants.ErrorSearchingLanguageData)
+		return
+	}
+
+	if len(matches) == 0 {
+		HandleError(c, http.StatusNotFound, fmt.Sprintf("No data for lexeme '%s'", lexemeID))
+		return
+	}
+
+	HandleSuccess(c, models.LexemeResponse{
+		LexemeID: lexemeID,
+		Results:  matches,
+	})
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// lexemeTestTables holds a German noun table, whose rowID column is selected like on MariaDB,
+// and English translations of German words.
+var lexemeTestTables = []string{
+	"CREATE TABLE DELanguageDataNounsScribe (rowID INTEGER PRIMARY KEY, lexemeID VARCHAR(64), singular TEXT)",
+	"INSERT INTO DELanguageDataNounsScribe VALUES (1, 'L1', 'Haus'), (2, 'L2', 'Baum')",
+	"CREATE TABLE DELanguageDataVerbsScribe (lexemeID VARCHAR(64), infinitive TEXT)",
+	"INSERT INTO DELanguageDataVerbsScribe VALUES ('L7', 'gehen')",
+	"CREATE TABLE TranslationDataENFromDE (lexemeID VARCHAR(64), word TEXT, translation TEXT)",
+	"INSERT INTO TranslationDataENFromDE VALUES ('L1', 'Haus', 'house'), ('L7', 'gehen', 'go')",
+}
+
+// MARK: Lexeme Lookup
+
+func TestGetLexeme(t *testing.T) {
+	useTestDB(t, lexemeTestTables...)
+
+	w := serveTestRequest(GetLexeme, gin.Params{{Key: "lexemeID", Value: "L1"}}, "", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+
+	var response models.LexemeResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []models.LexemeMatch{
+		{
+			Language: "de",
+			DataType: "nouns",
+			Fields:   map[string]string{"lexemeID": "varchar(64)", "singular": "text"},
+			Rows:     []map[string]any{{"lexemeID": "L1", "singular": "Haus"}},
+		},
+		{
+			Language:       "de",
+			TargetLanguage: "en",
+			DataType:       "translations",
+			Fields:         map[string]string{"lexemeID": "varchar(64)", "word": "text", "translation": "text"},
+			Rows:           []map[string]any{{"lexemeID": "L1", "word": "Haus", "translation": "house"}},
+		},
+	}
+	if response.LexemeID != "L1" || !reflect.DeepEqual(response.Results, want) {
+		t.Errorf("response = %+v, want results %+v", response, want)
+	}
+}
+
+func TestGetLexemeRejectsInvalidRequests(t *testing.T) {
+	useTestDB(t, lexemeTestTables...)
+
+	tests := []struct {
+		lexemeID string
+		want     int
+	}{
+		{"L99", http.StatusNotFound},
+		{"Q42", http.StatusBadRequest},
+		{"L1' OR '1'='1", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		if w := serveTestRequest(GetLexeme, gin.Params{{Key: "lexemeID", Value: tt.lexemeID}}, "", ""); w.Code != tt.want {
+			t.Errorf("GetLexeme(%q) status = %d, want %d", tt.lexemeID, w.Code, tt.want)
+		}
+	}
+}
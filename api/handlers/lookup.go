@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/dbqueries"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// MARK: Word Lookup
+
+// GetWordLookup returns the rows of every data type of a language in which a word appears as lemma or form.
+//
+// @Summary Look up a word in a language
+// @Description Searches all data types of the given language (e.g. nouns, verbs, emoji keywords) for rows where the word is the lemma or one of its forms, and returns them grouped by data type with their contract fields.
+// @Description Words are matched ignoring case against the lookup index built by the migration. At most 100 rows are returned per data type; further rows are continued with the next_cursor.
+// @Tags Language Data
+// @Accept  json
+// @Produce  json
+// @Param lang path string true "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(de)
+// @Param word query string true "Lemma or form to look up" example(Haus)
+// @Param cursor query string false "Cursor from the next_cursor field of the previous page"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
+// @Success 200 {object} models.LookupResponse "Successfully looked up the word"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the underlying data last changed"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid language code, cursor or missing word"
+// @Failure 404 {object} models.ErrorResponse "Language not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error while searching data"
+// @Router /api/v1/lookup/{lang} [get]
+func GetWordLookup(c *gin.Context) {
+	word := strings.TrimSpace(c.Query("word"))
+
+	if word == "" || utf8.RuneCountInString(word) > constants.MaxLookupWordLength {
+		HandleError(c, http.StatusBadRequest, constants.InvalidLookupWordError)
+		return
+	}
+
+	page, positions, err := parseLookupCursor(c)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, constants.InvalidCursorError)
+		return
+	}
+
+	lang, ok := requireLanguage(c, c.Param("lang"))
+	if !ok {
+		return
+	}
+
+	updatedAt, notModified := checkLanguageNotModified(c, lang)
+	if notModified {
+		return
+	}
+
+	dataTypes, err := database.GetLanguageDataTypes(lang)
+	if err != nil {
+		log.Printf("Error fetching data types for %s: %v", lang, err)
+		HandleError(c, http.StatusInternalServerError, "Failed to fetch language data types")
+		return
+	}
+
+	indexed, err := dbqueries.LookupIndexAvailable()
+	if err != nil {
+		log.Printf("Error checking the lookup index: %v", err)
+		HandleError(c, http.StatusInternalServerError, constants.ErrorSearchingLanguageData)
+		return
+	}
+
+	response := models.LookupResponse{
+		Language: lang,
+		Word:     word,
+		Contract: models.Contract{
+			Version:   constants.APIVersion,
+			UpdatedAt: formatUpdatedAt(updatedAt),
+			Fields:    make(map[string]map[string]string),
+		},
+		Results: make(map[string][]map[string]any),
+	}
+
+	nextAfter := make(map[string]string)
+	for _, dataType := range dataTypes {
+		if !indexed || !page.includes(dataType) {
+			continue
+		}
+
+		after, resumed := positions[dataType]
+		opts := dbqueries.TableOptions{Resumed: resumed, After: after, Limit: page.limit, Lookup: word}
+		if err := lookupDataType(&response, dataType, opts, nextAfter); err != nil {
+			log.Printf("Error searching %s/%s for %q: %v", lang, dataType, word, err)
+			HandleError(c, http.StatusInternalServerError, constants.ErrorSearchingLanguageData)
+			return
+		}
+	}
+	response.NextCursor = encodeCursor(nextAfter)
+
+	HandleSuccess(c, response)
+}
+
+// parseLookupCursor reads the cursor query parameter of a lookup into a page of at most constants.MaxLookupRows rows
+// per data type, along with the positions the data types carried in the cursor continue after.
+func parseLookupCursor(c *gin.Context) (pagination, map[string]database.RowPosition, error) {
+	page := pagination{enabled: true, limit: constants.MaxLookupRows, after: map[string]string{}}
+	if cursor := c.Query("cursor"); cursor != "" {
+		after, err := decodeCursor(cursor)
+		if err != nil {
+			return pagination{}, nil, err
+		}
+		page.resumed = true
+		page.after = after
+	}
+
+	positions, err := page.positions()
+	if err != nil {
+		return pagination{}, nil, err
+	}
+	return page, positions, nil
+}
+
+// lookupDataType adds the rows of a data type selected by opts to the response,
+// and records where the next page continues in nextAfter if the rows were cut at the page limit.
+func lookupDataType(response *models.LookupResponse, dataType string, opts dbqueries.TableOptions, nextAfter map[string]string) error {
+	rows, err := dbqueries.OpenLanguageTableRows(response.Language, dataType, opts)
+	// Tables migrated before the row ID column existed are not indexed.
+	if errors.Is(err, database.ErrPagingUnsupported) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// The rows are closed before the schema is fetched, so a lookup holds one connection at a time.
+	data := []map[string]any{}
+	for rows.Next() {
+		data = append(data, rows.Row())
+	}
+	err = rows.Err()
+	next := rows.NextKey()
+	rows.Close()
+	if err != nil || len(data) == 0 {
+		return err
+	}
+
+	schema, err := dbqueries.GetLanguageTableSchema(response.Language, dataType)
+	if err != nil {
+		return err
+	}
+
+	response.Contract.Fields[dataType] = schema
+	response.Results[dataType] = data
+	if next != "" {
+		nextAfter[dataType] = next
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// lookupTestTables holds German nouns and verbs along with their lookup index,
+// in which "gehen" is a form of more verbs than a single page holds.
+var lookupTestTables = append(dataTestTables,
+	"CREATE TABLE language_lookup_forms (language_iso TEXT, form TEXT COLLATE NOCASE, data_type TEXT, row_id INTEGER)",
+	`INSERT INTO language_lookup_forms VALUES
+		('de', 'Haus', 'nouns', 1), ('de', 'Häuser', 'nouns', 1), ('de', 'Baum', 'nouns', 2), ('de', 'gehen', 'verbs', 1)`,
+	fmt.Sprintf(`INSERT INTO DELanguageDataVerbsScribe
+		WITH RECURSIVE n(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < %d)
+		SELECT printf('L%%04d', 100 + i), 'gehen' FROM n`, constants.MaxLookupRows),
+	`INSERT INTO language_lookup_forms SELECT 'de', 'gehen', 'verbs', rowid FROM DELanguageDataVerbsScribe WHERE rowid > 1`,
+)
+
+// lookUp serves a word lookup and decodes its response.
+func lookUp(t *testing.T, query string) models.LookupResponse {
+	t.Helper()
+
+	w := serveTestRequest(GetWordLookup, gin.Params{{Key: "lang", Value: "de"}}, query, "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("lookup %q: status = %d, body %s", query, w.Code, w.Body)
+	}
+
+	var response models.LookupResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	return response
+}
+
+// MARK: Word Lookup
+
+func TestGetWordLookup(t *testing.T) {
+	useTestDB(t, lookupTestTables...)
+
+	response := lookUp(t, "word=h%C3%A4user")
+	want := map[string][]map[string]any{
+		"nouns": {{"lexemeID": "L1", "singular": "Haus", "plural": "Häuser", "count": "2"}},
+	}
+	if !reflect.DeepEqual(response.Results, want) {
+		t.Errorf("results = %v, want %v", response.Results, want)
+	}
+	if _, ok := response.Contract.Fields["nouns"]["singular"]; !ok || len(response.Contract.Fields) != 1 {
+		t.Errorf("contract fields = %v, want the noun schema only", response.Contract.Fields)
+	}
+	if response.NextCursor != "" {
+		t.Errorf("next_cursor = %q, want none", response.NextCursor)
+	}
+
+	if response := lookUp(t, "word=Hau"); len(response.Results) != 0 {
+		t.Errorf("results for a prefix = %v, want none", response.Results)
+	}
+}
+
+func TestGetWordLookupPagesThroughTruncatedResults(t *testing.T) {
+	useTestDB(t, lookupTestTables...)
+
+	response := lookUp(t, "word=gehen")
+	if got := len(response.Results["verbs"]); got != constants.MaxLookupRows {
+		t.Fatalf("first page holds %d verbs, want %d", got, constants.MaxLookupRows)
+	}
+	if response.NextCursor == "" {
+		t.Fatal("truncated results have no next_cursor")
+	}
+
+	next := lookUp(t, "word=gehen&cursor="+response.NextCursor)
+	if got := len(next.Results["verbs"]); got != 1 || next.NextCursor != "" {
+		t.Errorf("second page holds %d verbs and cursor %q, want the last verb and no cursor", got, next.NextCursor)
+	}
+	// Rows are ordered by lexeme ID, which sorts L7 after the generated ones.
+	if last := next.Results["verbs"][0]["lexemeID"]; last != "L7" {
+		t.Errorf("second page holds %v, want L7", last)
+	}
+}
+
+func TestGetWordLookupRejectsInvalidRequests(t *testing.T) {
+	useTestDB(t, lookupTestTables...)
+
+	for _, query := range []string{"", "word=%20", "word=Haus&cursor=invalid"} {
+		w := serveTestRequest(GetWordLookup, gin.Params{{Key: "lang", Value: "de"}}, query, "")
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("lookup %q: status = %d, want 400", query, w.Code)
+		}
+	}
+}
+
+func TestGetWordLookupWithoutIndex(t *testing.T) {
+	useTestDB(t, dataTestTables...)
+
+	if response := lookUp(t, "word=Haus"); len(response.Results) != 0 {
+		t.Errorf("results without the lookup index = %v, want none", response.Results)
+	}
+}
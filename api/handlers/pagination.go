@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+)
+
+// pagination holds the parsed paging parameters of a data request.
+type pagination struct {
+	// Whether the client asked for a paged response at all
+	enabled bool
+	// Maximum number of rows per data type
+	limit int
+	// Whether a cursor from a previous page was passed
+	resumed bool
+	// Key of the last row served per data type
+	after map[string]string
+}
+
+// parsePagination reads the limit and cursor query parameters.
+// Requests without either parameter are served unpaginated.
+func parsePagination(c *gin.Context) (pagination, error) {
+	limitParam := c.Query("limit")
+	cursorParam := c.Query("cursor")
+
+	if limitParam == "" && cursorParam == "" {
+		return pagination{}, nil
+	}
+
+	p := pagination{
+		enabled: true,
+		limit:   constants.DefaultPageLimit,
+		after:   map[string]string{},
+	}
+
+	if limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 1 || limit > constants.MaxPageLimit {
+			return pagination{}, errors.New(constants.InvalidPageLimitError)
+		}
+		p.limit = limit
+	}
+
+	if cursorParam != "" {
+		after, err := decodeCursor(cursorParam)
+		if err != nil {
+			return pagination{}, errors.New(constants.InvalidCursorError)
+		}
+		p.resumed = true
+		p.after = after
+	}
+
+	return p, nil
+}
+
+// includes reports whether a data type still has rows to serve on this page.
+// After the first page only data types carried in the cursor are continued.
+func (p pagination) includes(dataType string) bool {
+	if !p.resumed {
+		return true
+	}
+	_, ok := p.after[dataType]
+	return ok
+}
+
+// positions decodes the resume keys of the cursor into the row positions of language data tables.
+func (p pagination) positions() (map[string]database.RowPosition, error) {
+	positions := make(map[string]database.RowPosition, len(p.after))
+	for dataType, key := range p.after {
+		position, err := database.ParseRowPosition(key)
+		if err != nil {
+			return nil, errors.New(constants.InvalidCursorError)
+		}
+		positions[dataType] = position
+	}
+	return positions, nil
+}
+
+// MARK: Cursor Encoding
+
+// encodeCursor serializes the per data type resume keys into an opaque URL-safe token.
+// An empty map yields an empty cursor, signalling that there are no further pages.
+func encodeCursor(after map[string]string) string {
+	if len(after) == 0 {
+		return ""
+	}
+
+	raw, err := json.Marshal(after)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor parses a cursor produced by encodeCursor.
+// Cursors longer than constants.MaxCursorLength are rejected before decoding, as no page produces one.
+func decodeCursor(cursor string) (map[string]string, error) {
+	if len(cursor) > constants.MaxCursorLength {
+		return nil, errors.New("cursor too long")
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var after map[string]string
+	if err := json.Unmarshal(raw, &after); err != nil {
+		return nil, err
+	}
+	if len(after) == 0 {
+		return nil, errors.New("empty cursor")
+	}
+	return after, nil
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/base64"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+)
+
+// MARK: Cursor Encoding
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []map[string]string{
+		{"nouns": "7:L123"},
+		{"nouns": "7:L123", "verbs": "12:L9", "adjectives": "0:"},
+		{translationCursorKey: "Straße & \"Haus\"/?"},
+	}
+
+	for _, after := range tests {
+		cursor := encodeCursor(after)
+		if url.QueryEscape(cursor) != cursor {
+			t.Errorf("cursor %q is not URL safe", cursor)
+		}
+
+		decoded, err := decodeCursor(cursor)
+		if err != nil {
+			t.Fatalf("decodeCursor(%q): %v", cursor, err)
+		}
+		if !reflect.DeepEqual(decoded, after) {
+			t.Errorf("decodeCursor(encodeCursor(%v)) = %v", after, decoded)
+		}
+	}
+
+	if cursor := encodeCursor(nil); cursor != "" {
+		t.Errorf("encodeCursor(nil) = %q, want no cursor", cursor)
+	}
+}
+
+func TestDecodeCursorRejectsInvalidCursors(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+	valid := encodeCursor(map[string]string{"nouns": "7:L123"})
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "not a cursor!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"nouns":"7:L1"}`))},
+		{"standard base64 alphabet", strings.NewReplacer("-", "+", "_", "/").Replace(encode(`{"nouns":"7:L1?>"}`))},
+		{"truncated", valid[:len(valid)-3]},
+		{"tampered byte", "X" + valid[1:]},
+		{"not JSON", encode("nouns=7:L123")},
+		{"JSON array", encode(`["7:L123"]`)},
+		{"JSON null", encode("null")},
+		{"empty object", encode("{}")},
+		{"non-string position", encode(`{"nouns":7}`)},
+		{"nested position", encode(`{"nouns":{"rowID":7}}`)},
+		{"oversized", encode(`{"nouns":"7:` + strings.Repeat("L", constants.MaxCursorLength) + `"}`)},
+	}
+
+	for _, tt := range tests {
+		if after, err := decodeCursor(tt.cursor); err == nil {
+			t.Errorf("%s: decodeCursor = %v, want error", tt.name, after)
+		}
+	}
+}
+
+func TestPaginationPositionsRejectsTamperedKeys(t *testing.T) {
+	for _, key := range []string{"L123", "x:L123", "-1:L123", "1e3:L123"} {
+		p := pagination{enabled: true, resumed: true, after: map[string]string{"nouns": key}}
+		if _, err := p.positions(); err == nil || err.Error() != constants.InvalidCursorError {
+			t.Errorf("positions with key %q = %v, want %q", key, err, constants.InvalidCursorError)
+		}
+	}
+
+	p := pagination{enabled: true, resumed: true, after: map[string]string{"nouns": "7:L123"}}
+	positions, err := p.positions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (database.RowPosition{Key: "L123", RowID: 7}); positions["nouns"] != want {
+		t.Errorf("positions = %v, want nouns at %v", positions, want)
+	}
+}
+
+// MARK: Query Parameters
+
+func TestParsePagination(t *testing.T) {
+	cursor := encodeCursor(map[string]string{"nouns": "7:L123"})
+	oversized := strings.Repeat("A", constants.MaxCursorLength+1)
+
+	tests := []struct {
+		query   string
+		want    pagination
+		wantErr string
+	}{
+		{"", pagination{}, ""},
+		{"limit=5", pagination{enabled: true, limit: 5, after: map[string]string{}}, ""},
+		{"cursor=" + cursor, pagination{enabled: true, limit: constants.DefaultPageLimit, resumed: true, after: map[string]string{"nouns": "7:L123"}}, ""},
+		{"limit=0", pagination{}, constants.InvalidPageLimitError},
+		{"limit=10001", pagination{}, constants.InvalidPageLimitError},
+		{"limit=ten", pagination{}, constants.InvalidPageLimitError},
+		{"cursor=%27%20OR%201%3D1", pagination{}, constants.InvalidCursorError},
+		{"limit=5&cursor=" + oversized, pagination{}, constants.InvalidCursorError},
+	}
+
+	for _, tt := range tests {
+		c, _ := newTestContext(tt.query, "")
+		got, err := parsePagination(c)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("parsePagination(%q) error = %v, want %q", tt.query, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePagination(%q) = %+v, %v, want %+v", tt.query, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/ugorji/go/codec"
+)
+
+// MARK: Response Formats
+
+// responseFormat is a representation a response can be rendered in.
+type responseFormat string
+
+// Response formats selectable with the format query parameter.
+const (
+	formatJSON    responseFormat = "json"
+	formatYAML    responseFormat = "yaml"
+	formatNDJSON  responseFormat = "ndjson"
+	formatCSV     responseFormat = "csv"
+	formatMsgPack responseFormat = "msgpack"
+	formatCBOR    responseFormat = "cbor"
+)
+
+// formatMediaTypes lists the media types accepted for each format; the first one is sent as Content-Type.
+var formatMediaTypes = map[responseFormat][]string{
+	formatJSON:    {"application/json"},
+	formatYAML:    {"application/x-yaml", "application/yaml", "text/yaml"},
+	formatNDJSON:  {"application/x-ndjson", "application/ndjson", "application/jsonl"},
+	formatCSV:     {"text/csv"},
+	formatMsgPack: {"application/msgpack", "application/x-msgpack", "application/vnd.msgpack"},
+	formatCBOR:    {"application/cbor"},
+}
+
+// Formats offered by the endpoints, in order of server preference; the first one is the default.
+var (
+	// tableFormats suit responses made of a single table of rows.
+	tableFormats = []responseFormat{formatJSON, formatYAML, formatNDJSON, formatCSV, formatMsgPack, formatCBOR}
+	// documentFormats suit responses that do not flatten into a single table.
+	documentFormats = []responseFormat{formatJSON, formatYAML, formatNDJSON, formatMsgPack, formatCBOR}
+	// contractFormats keep YAML, the format contracts are written in, as the default.
+	contractFormats = []responseFormat{formatYAML, formatJSON, formatNDJSON, formatMsgPack, formatCBOR}
+)
+
+// formatContextKey stores the negotiated format in the request context, so that entity tags can tell formats apart.
+const formatContextKey = "responseFormat"
+
+// nextCursorHeader carries the cursor to the next page in formats that hold nothing but records.
+const nextCursorHeader = "X-Next-Cursor"
+
+// MARK: Negotiation
+
+// negotiateFormat picks the response format from the format query parameter, or else the Accept header.
+// Without either, the first offered format is used. It writes a 400 response for an unknown format parameter
+// or a 406 response if the Accept header allows none of the offered formats, and returns false.
+func negotiateFormat(c *gin.Context, offered []responseFormat) (responseFormat, bool) {
+	c.Writer.Header().Add("Vary", "Accept")
+
+	format := offered[0]
+	if param := strings.ToLower(strings.TrimSpace(c.Query("format"))); param != "" {
+		if !slices.Contains(offered, responseFormat(param)) {
+			HandleError(c, http.StatusBadRequest, constants.InvalidFormatError)
+			return "", false
+		}
+		format = responseFormat(param)
+	} else if accept := c.GetHeader("Accept"); strings.TrimSpace(accept) != "" {
+		ranges := parseAccept(accept)
+		bestQ := 0.0
+		format = ""
+		for _, candidate := range offered {
+			if q := formatQuality(ranges, candidate); q > bestQ {
+				format, bestQ = candidate, q
+			}
+		}
+		if format == "" {
+			HandleError(c, http.StatusNotAcceptable, constants.NotAcceptableError)
+			return "", false
+		}
+	}
+
+	c.Set(formatContextKey, string(format))
+	return format, true
+}
+
+// mediaRange is one entry of an Accept header, e.g. text/* with its quality.
+type mediaRange struct {
+	typ     string
+	subtype string
+	q       float64
+}
+
+// parseAccept reads the media ranges of an Accept header, skipping malformed entries.
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		typ, subtype, ok := strings.Cut(strings.ToLower(strings.TrimSpace(mediaType)), "/")
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				parsed, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					q = 0
+				} else {
+					q = parsed
+				}
+			}
+		}
+
+		ranges = append(ranges, mediaRange{typ: typ, subtype: subtype, q: q})
+	}
+	return ranges
+}
+
+// formatQuality returns the quality the client gives a format, taken from the most specific range matching any of its media types.
+func formatQuality(ranges []mediaRange, format responseFormat) float64 {
+	best := 0.0
+	for _, mediaType := range formatMediaTypes[format] {
+		typ, subtype, _ := strings.Cut(mediaType, "/")
+
+		q, specificity := 0.0, -1
+		for _, r := range ranges {
+			match := -1
+			switch {
+			case r.typ == typ && r.subtype == subtype:
+				match = 2
+			case r.typ == typ && r.subtype == "*":
+				match = 1
+			case r.typ == "*" && r.subtype == "*":
+				match = 0
+			}
+			if match > specificity {
+				q, specificity = r.q, match
+			}
+		}
+
+		best = max(best, q)
+	}
+	return best
+}
+
+// MARK: Rendering
+
+// recordSet is a response flattened into records for the NDJSON and CSV formats.
+type recordSet struct {
+	// Column names in CSV order, only needed if the endpoint offers CSV
+	columns []string
+	// Records to write; CSV requires each to be a map[string]any
+	records []any
+	// Cursor to the next page, sent in the X-Next-Cursor header
+	nextCursor string
+}
+
+// msgpackHandle and cborHandle configure the binary encoders; field names follow the json struct tags.
+var (
+	msgpackHandle = &codec.MsgpackHandle{WriteExt: true}
+	cborHandle    = &codec.CborHandle{}
+)
+
+// renderResponse writes data with status 200 in the given format.
+// flatten turns the response into records for NDJSON and CSV; it may be nil if the endpoint offers neither.
+func renderResponse(c *gin.Context, format responseFormat, data any, flatten func() recordSet) {
+	switch format {
+	case formatYAML:
+		generic, err := toGeneric(data)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to encode YAML")
+			return
+		}
+		HandleYAMLSuccess(c, generic)
+
+	case formatMsgPack, formatCBOR:
+		var handle codec.Handle = msgpackHandle
+		if format == formatCBOR {
+			handle = cborHandle
+		}
+
+		var out []byte
+		if err := codec.NewEncoderBytes(&out, handle).Encode(data); err != nil {
+			HandleError(c, http.StatusInternalServerError, fmt.Sprintf("Failed to encode %s", format))
+			return
+		}
+		c.Data(http.StatusOK, formatMediaTypes[format][0], out)
+
+	case formatNDJSON, formatCSV:
+		set := flatten()
+		if set.nextCursor != "" {
+			c.Header(nextCursorHeader, set.nextCursor)
+		}
+
+		stream := newRecordStream(c, format, set.columns)
+		for _, record := range set.records {
+			stream.write(record)
+		}
+		if err := stream.close(); err != nil {
+			log.Printf("Error writing %s response: %v", format, err)
+		}
+
+	default:
+		HandleSuccess(c, data)
+	}
+}
+
+// toGeneric converts a value into the maps, slices and scalars of its JSON form,
+// so that encoders without knowledge of the json struct tags produce the same field names.
+func toGeneric(data any) (any, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Numbers are decoded as json.Number to keep large integers exact, then turned back into Go numbers,
+	// as YAML encoders would otherwise write them as quoted strings.
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return resolveNumbers(generic), nil
+}
+
+// resolveNumbers replaces every json.Number within a decoded JSON value by an int64, or a float64 if it is not an integer.
+func resolveNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = resolveNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = resolveNumbers(item)
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	}
+	return value
+}
+
+// MARK: Record Streaming
+
+// recordStream writes records to the response as NDJSON lines or CSV rows.
+// Like jsonStream it sends the body in chunks, keeps the first write error and skips all later writes.
+type recordStream struct {
+	format  responseFormat
+	columns []string
+	w       *bufio.Writer
+	csv     *csv.Writer
+	flusher http.Flusher
+	err     error
+}
+
+// newRecordStream starts a 200 response in the given record format, writing the CSV header row if needed.
+func newRecordStream(c *gin.Context, format responseFormat, columns []string) *recordStream {
+	c.Header("Content-Type", formatMediaTypes[format][0]+"; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	s := &recordStream{
+		format:  format,
+		columns: columns,
+		w:       bufio.NewWriterSize(c.Writer, streamBufferSize),
+		flusher: c.Writer,
+	}
+
+	if format == formatCSV {
+		s.csv = csv.NewWriter(s.w)
+		s.err = s.csv.Write(columns)
+	}
+
+	return s
+}
+
+// write adds a single record to the response.
+func (s *recordStream) write(record any) {
+	if s.err != nil {
+		return
+	}
+
+	if s.format == formatNDJSON {
+		encoded, err := json.Marshal(record)
+		if err != nil {
+			s.err = err
+			return
+		}
+		if _, s.err = s.w.Write(encoded); s.err == nil {
+			s.err = s.w.WriteByte('\n')
+		}
+		return
+	}
+
+	row, ok := record.(map[string]any)
+	if !ok {
+		s.err = fmt.Errorf("record of type %T cannot be written as CSV", record)
+		return
+	}
+
+	values := make([]string, len(s.columns))
+	for i, column := range s.columns {
+		values[i] = csvValue(row[column])
+	}
+	s.err = s.csv.Write(values)
+}
+
+// close flushes all buffered output to the client and returns the first error that occurred.
+func (s *recordStream) close() error {
+	if s.csv != nil && s.err == nil {
+		s.csv.Flush()
+		s.err = s.csv.Error()
+	}
+	if s.err == nil {
+		if s.err = s.w.Flush(); s.err == nil {
+			s.flusher.Flush()
+		}
+	}
+	return s.err
+}
+
+// csvValue renders a column value as a CSV field; NULL becomes an empty field.
+func csvValue(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	case time.Time:
+		return value.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ugorji/go/codec"
+	"gopkg.in/yaml.v3"
+)
+
+// newTestContext returns a context for a GET request with the given query and Accept header.
+func newTestContext(query, accept string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+	if accept != "" {
+		c.Request.Header.Set("Accept", accept)
+	}
+	return c, w
+}
+
+// MARK: Negotiation
+
+func TestParseAccept(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   []mediaRange
+	}{
+		{"application/json", []mediaRange{{"application", "json", 1}}},
+		{"Text/CSV; charset=utf-8", []mediaRange{{"text", "csv", 1}}},
+		{"application/json;q=0", []mediaRange{{"application", "json", 0}}},
+		{"application/*; q=0.5, */*;q=0.1", []mediaRange{{"application", "*", 0.5}, {"*", "*", 0.1}}},
+		{"application/cbor;q=oops", []mediaRange{{"application", "cbor", 0}}},
+		{"garbage, , text/yaml", []mediaRange{{"text", "yaml", 1}}},
+	}
+
+	for _, tt := range tests {
+		if got := parseAccept(tt.accept); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseAccept(%q) = %v, want %v", tt.accept, got, tt.want)
+		}
+	}
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		accept     string
+		offered    []responseFormat
+		want       responseFormat
+		wantStatus int
+	}{
+		{"default without preference", "", "", tableFormats, formatJSON, 0},
+		{"default of contracts", "", "", contractFormats, formatYAML, 0},
+		{"exact media type", "", "application/x-ndjson", tableFormats, formatNDJSON, 0},
+		{"alternative media type", "", "application/vnd.msgpack", tableFormats, formatMsgPack, 0},
+		{"any media type", "", "*/*", tableFormats, formatJSON, 0},
+		{"subtype wildcard", "", "application/*", tableFormats, formatJSON, 0},
+		{"subtype wildcard keeps server preference", "", "application/*", contractFormats, formatYAML, 0},
+		{"text wildcard", "", "text/*", documentFormats, formatYAML, 0},
+		{"highest quality wins", "", "application/cbor;q=0.5, application/msgpack;q=0.8", tableFormats, formatMsgPack, 0},
+		{"q=0 excludes a format matched by a wildcard", "", "application/json;q=0, */*", tableFormats, formatYAML, 0},
+		{"q=0 excludes the only format", "", "application/json;q=0", tableFormats, "", http.StatusNotAcceptable},
+		{"format not offered", "", "text/csv", documentFormats, "", http.StatusNotAcceptable},
+		{"format parameter", "format=cbor", "", tableFormats, formatCBOR, 0},
+		{"format parameter is case insensitive", "format=YAML", "", tableFormats, formatYAML, 0},
+		{"format parameter overrides Accept", "format=csv", "application/json", tableFormats, formatCSV, 0},
+		{"unknown format parameter", "format=xml", "", tableFormats, "", http.StatusBadRequest},
+		{"format parameter not offered", "format=csv", "", documentFormats, "", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newTestContext(tt.query, tt.accept)
+
+			got, ok := negotiateFormat(c, tt.offered)
+			if ok != (tt.wantStatus == 0) || got != tt.want {
+				t.Fatalf("negotiateFormat = %q, %v, want %q", got, ok, tt.want)
+			}
+			if !ok && w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if ok && c.GetString(formatContextKey) != string(tt.want) {
+				t.Errorf("context format = %q, want %q", c.GetString(formatContextKey), tt.want)
+			}
+			if vary := w.Header().Get("Vary"); vary != "Accept" {
+				t.Errorf("Vary = %q, want Accept", vary)
+			}
+		})
+	}
+}
+
+// MARK: Rendering
+
+// renderTestData is a response with the field types the endpoints render.
+type renderTestData struct {
+	Language string         `json:"language"`
+	Total    int64          `json:"totalRows"`
+	Large    int64          `json:"large"`
+	Ratio    float64        `json:"ratio"`
+	Forms    []string       `json:"forms"`
+	Counts   map[string]int `json:"counts"`
+	Missing  *string        `json:"missing"`
+}
+
+var renderTestValue = renderTestData{
+	Language: "de",
+	Total:    42,
+	Large:    9007199254740993,
+	Ratio:    0.25,
+	Forms:    []string{"Haus", "Häuser"},
+	Counts:   map[string]int{"nouns": 3, "verbs": 0},
+}
+
+// renderTestRecords flattens renderTestValue into one record per form.
+func renderTestRecords() recordSet {
+	return recordSet{
+		columns: []string{"language", "form"},
+		records: []any{
+			map[string]any{"language": "de", "form": "Haus"},
+			map[string]any{"language": "de", "form": "Häuser"},
+		},
+		nextCursor: "next",
+	}
+}
+
+// normalize turns the output of any decoder into the values json.Unmarshal produces,
+// so that decoded responses can be compared regardless of format.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalize(item)
+		}
+		return v
+	case map[any]any:
+		converted := make(map[string]any, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = normalize(item)
+		}
+		return converted
+	case []any:
+		for i, item := range v {
+			v[i] = normalize(item)
+		}
+		return v
+	case []byte:
+		return string(v)
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	}
+	return value
+}
+
+func TestRenderResponseRoundTrip(t *testing.T) {
+	encoded, _ := json.Marshal(renderTestValue)
+	var document any
+	if err := json.Unmarshal(encoded, &document); err != nil {
+		t.Fatal(err)
+	}
+
+	records := renderTestRecords().records
+
+	msgpackDecoding := &codec.MsgpackHandle{}
+	msgpackDecoding.RawToString = true
+
+	decodeCodec := func(handle codec.Handle) func([]byte) (any, error) {
+		return func(body []byte) (any, error) {
+			var decoded any
+			err := codec.NewDecoderBytes(body, handle).Decode(&decoded)
+			return decoded, err
+		}
+	}
+
+	tests := []struct {
+		format      responseFormat
+		contentType string
+		want        any
+		decode      func([]byte) (any, error)
+	}{
+		{formatJSON, "application/json", document, func(body []byte) (any, error) {
+			var decoded any
+			err := json.Unmarshal(body, &decoded)
+			return decoded, err
+		}},
+		{formatYAML, "application/x-yaml", document, func(body []byte) (any, error) {
+			var decoded any
+			err := yaml.Unmarshal(body, &decoded)
+			return decoded, err
+		}},
+		{formatMsgPack, "application/msgpack", document, decodeCodec(msgpackDecoding)},
+		{formatCBOR, "application/cbor", document, decodeCodec(&codec.CborHandle{})},
+		{formatNDJSON, "application/x-ndjson", records, func(body []byte) (any, error) {
+			var decoded []any
+			scanner := bufio.NewScanner(bytes.NewReader(body))
+			for scanner.Scan() {
+				var record any
+				if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+					return nil, err
+				}
+				decoded = append(decoded, record)
+			}
+			return decoded, scanner.Err()
+		}},
+		{formatCSV, "text/csv", records, func(body []byte) (any, error) {
+			rows, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+			if err != nil || len(rows) == 0 {
+				return nil, err
+			}
+			var decoded []any
+			for _, row := range rows[1:] {
+				record := make(map[string]any, len(row))
+				for i, column := range rows[0] {
+					record[column] = row[i]
+				}
+				decoded = append(decoded, record)
+			}
+			return decoded, nil
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			c, w := newTestContext("", "")
+			renderResponse(c, tt.format, renderTestValue, renderTestRecords)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, body %s", w.Code, w.Body)
+			}
+			if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, tt.contentType) {
+				t.Errorf("Content-Type = %q, want %q", contentType, tt.contentType)
+			}
+
+			decoded, err := tt.decode(w.Body.Bytes())
+			if err != nil {
+				t.Fatalf("decoding %s: %v\n%s", tt.format, err, w.Body)
+			}
+			if got := normalize(decoded); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decoded %s = %#v, want %#v", tt.format, got, tt.want)
+			}
+
+			isRecordFormat := tt.format == formatNDJSON || tt.format == formatCSV
+			if cursor := w.Header().Get(nextCursorHeader); (cursor == "next") != isRecordFormat {
+				t.Errorf("%s = %q for format %s", nextCursorHeader, cursor, tt.format)
+			}
+		})
+	}
+}
+
+func TestRenderYAMLWritesNumbersUnquoted(t *testing.T) {
+	c, w := newTestContext("", "")
+	renderResponse(c, formatYAML, renderTestValue, nil)
+
+	body := w.Body.String()
+	for _, line := range []string{"totalRows: 42", "large: 9007199254740993", "ratio: 0.25", "nouns: 3", "missing: null"} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("YAML response does not contain %q:\n%s", line, body)
+		}
+	}
+
+	var decoded map[string]any
+	if err := yaml.Unmarshal(w.Body.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if large, ok := decoded["large"].(int); !ok || int64(large) != renderTestValue.Large {
+		t.Errorf("large = %#v, want the exact integer %d", decoded["large"], renderTestValue.Large)
+	}
+}
+
+func TestResolveNumbers(t *testing.T) {
+	generic, err := toGeneric(map[string]any{
+		"int":    int64(-12),
+		"float":  1.5,
+		"nested": []any{map[string]any{"n": 7}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"int":    int64(-12),
+		"float":  1.5,
+		"nested": []any{map[string]any{"n": int64(7)}},
+	}
+	if !reflect.DeepEqual(generic, want) {
+		t.Errorf("toGeneric = %#v, want %#v", generic, want)
+	}
+}
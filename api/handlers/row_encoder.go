@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"unicode/utf8"
+
+	"github.com/scribe-org/scribe-server/api/dbqueries"
+)
+
+// MARK: Row Encoding
+
+// rowEncoder writes the current row of a table stream as JSON without building a map or value slice per row.
+// The encoded row is only valid until the next call to encode, as the buffer is reused.
+type rowEncoder struct {
+	layout rowLayout
+	// Encoded object keys including the colon, e.g. `"singular":`, only used for layoutObjects
+	keys [][]byte
+	buf  []byte
+}
+
+// newRowEncoder prepares an encoder for rows with the given columns.
+func newRowEncoder(columns []string, layout rowLayout) *rowEncoder {
+	e := &rowEncoder{layout: layout}
+	if layout == layoutObjects {
+		e.keys = make([][]byte, len(columns))
+		for i, column := range columns {
+			key, _ := json.Marshal(column)
+			e.keys[i] = append(key, ':')
+		}
+	}
+	return e
+}
+
+// encode renders the current row as a JSON object, or as an array of values in the columnar layout.
+func (e *rowEncoder) encode(rows *dbqueries.LanguageTableRows) []byte {
+	open, closing := byte('{'), byte('}')
+	if e.layout == layoutColumnar {
+		open, closing = '[', ']'
+	}
+
+	e.buf = append(e.buf[:0], open)
+	for i := range rows.Columns() {
+		if i > 0 {
+			e.buf = append(e.buf, ',')
+		}
+		if e.layout == layoutObjects {
+			e.buf = append(e.buf, e.keys[i]...)
+		}
+
+		if value := rows.Value(i); value != nil {
+			e.buf = appendJSONString(e.buf, value)
+		} else {
+			e.buf = append(e.buf, "null"...)
+		}
+	}
+
+	return append(e.buf, closing)
+}
+
+// appendJSONString appends s as a quoted JSON string, escaping it like encoding/json does.
+// Invalid UTF-8 is replaced by U+FFFD.
+func appendJSONString(dst, s []byte) []byte {
+	const hex = "0123456789abcdef"
+
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+
+			dst = append(dst, s[start:i]...)
+			switch b {
+			case '"', '\\':
+				dst = append(dst, '\\', b)
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRune(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, "\ufffd"...)
+		case r == '\u2028' || r == '\u2029':
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[r&0xF])
+		default:
+			i += size
+			continue
+		}
+		i += size
+		start = i
+	}
+
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
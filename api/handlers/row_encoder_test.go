@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	_ "github.com/glebarez/sqlite"
+	"github.com/scribe-org/scribe-server/api/dbqueries"
+	"github.com/scribe-org/scribe-server/database"
+)
+
+// MARK: String Escaping
+
+func TestAppendJSONStringMatchesEncodingJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"plain", "Haus"},
+		{"non ASCII", "Straße – ευχαριστώ 🙂"},
+		{"quotes and backslashes", `say "hi" \ bye`},
+		{"control characters", "a\x00b\x01c\x08d\x0be\x0cf\x1fg"},
+		{"whitespace escapes", "line\nbreak\rreturn\ttab"},
+		{"HTML characters", "<script>a && b</script>"},
+		{"line and paragraph separators", "a b c"},
+		{"invalid UTF-8", "a\xffb\xc3(c\xed\xa0\x80"},
+		{"truncated rune at end", "abc\xe2\x82"},
+		{"DEL is not escaped", "a\x7fb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := appendJSONString(nil, []byte(tt.input)); string(got) != string(want) {
+				t.Errorf("appendJSONString(%q) = %s, want %s", tt.input, got, want)
+			}
+		})
+	}
+}
+
+func TestAppendJSONStringAppendsToDestination(t *testing.T) {
+	if got := appendJSONString([]byte("prefix,"), []byte("x")); string(got) != `prefix,"x"` {
+		t.Errorf("appendJSONString kept %s, want the destination prefixed", got)
+	}
+}
+
+// MARK: Row Encoding
+
+// openEncoderRows streams the rows of a test table from an in-memory SQLite database.
+func openEncoderRows(t *testing.T) *dbqueries.LanguageTableRows {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("opening test database: %v", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	for _, statement := range []string{
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, singular TEXT, count INTEGER, weight REAL)",
+		"INSERT INTO DELanguageDataNounsScribe VALUES ('L1', 'a<b>\n ', 42, 1.5), ('L2', NULL, NULL, NULL), ('L3', 'x\xffy', -7, 0.25)",
+	} {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("preparing test database: %v", err)
+		}
+	}
+
+	previous := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previous
+		db.Close()
+	})
+
+	rows, err := database.OpenTableRows(database.TableQuery{
+		TableName: "DELanguageDataNounsScribe",
+		KeyColumn: database.LexemeIDColumn,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rows.Close() })
+
+	return &dbqueries.LanguageTableRows{RowIterator: rows}
+}
+
+func TestRowEncoderMatchesEncodingJSON(t *testing.T) {
+	columns := []string{"lexemeID", "singular", "count", "weight"}
+	// Values of each row in column order; numbers are rendered as strings like every other column.
+	rowValues := [][]any{
+		{"L1", "a<b>\n ", "42", "1.5"},
+		{"L2", nil, nil, nil},
+		{"L3", "x\xffy", "-7", "0.25"},
+	}
+
+	tests := []struct {
+		layout rowLayout
+		want   func(values []any) string
+	}{
+		{layoutObjects, func(values []any) string {
+			encoded := "{"
+			for i, column := range columns {
+				if i > 0 {
+					encoded += ","
+				}
+				key, _ := json.Marshal(column)
+				value, _ := json.Marshal(values[i])
+				encoded += string(key) + ":" + string(value)
+			}
+			return encoded + "}"
+		}},
+		{layoutColumnar, func(values []any) string {
+			encoded, _ := json.Marshal(values)
+			return string(encoded)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.layout), func(t *testing.T) {
+			rows := openEncoderRows(t)
+			encoder := newRowEncoder(rows.Columns(), tt.layout)
+
+			i := 0
+			for ; rows.Next(); i++ {
+				if i >= len(rowValues) {
+					t.Fatalf("more rows than inserted")
+				}
+				if got, want := string(encoder.encode(rows)), tt.want(rowValues[i]); got != want {
+					t.Errorf("row %d = %s, want %s", i, got, want)
+				}
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if i != len(rowValues) {
+				t.Errorf("encoded %d rows, want %d", i, len(rowValues))
+			}
+		})
+	}
+}
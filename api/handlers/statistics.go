@@ -5,38 +5,50 @@ package handlers
 
 import (
 	"log"
+	"math"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/dbqueries"
 	"github.com/scribe-org/scribe-server/api/validators"
 	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
 	"github.com/scribe-org/scribe-server/models"
 )
 
 // GetLanguageStats handles GET /language-stats?codes=en,fr.
 // @Summary Get statistics for one or multiple languages
-// @Description Returns the number of nouns and verbs for the specified language codes.
+// @Description Returns the number of entries of every data type and of translations into each target language for the specified language codes.
 // @Tags statistics
 // @Accept json
-// @Produce json
+// @Produce json,application/x-yaml,application/x-ndjson,application/msgpack,application/cbor
 // @Param codes query string false "Comma-separated list of language codes to filter (e.g., "fr,de,es")"
+// @Param format query string false "Response format, overriding the Accept header" Enums(json, yaml, ndjson, msgpack, cbor)
+// @Param Accept-Language header string false "Languages to give display names in (e.g., "de-CH, de;q=0.9")"
 // @Success 200 {array} models.LanguageStatisticsReponse "List of language statistics"
 // @Failure 400 {object} models.ErrorResponse "Bad request"
 // @Failure 404 {object} models.ErrorResponse "Language not found"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /api/v1/language-stats [get]
 func GetLanguageStats(c *gin.Context) {
+	format, ok := negotiateFormat(c, documentFormats)
+	if !ok {
+		return
+	}
+
 	codesParam := c.Query("codes")
+	displayIn := displayLanguage(c)
 
 	if codesParam == "" {
-		allStats, err := database.GetAllLanguageStats()
+		allStats, err := database.GetAllLanguageStats(displayIn)
 		if err != nil {
 			log.Printf("Error fetching all stats: %v", err)
 			HandleError(c, http.StatusInternalServerError, "Failed to fetch all language statistics")
 			return
 		}
-		HandleSuccess(c, allStats)
+		renderLanguageStats(c, format, allStats)
 		return
 	}
 
@@ -70,7 +82,7 @@ func GetLanguageStats(c *gin.Context) {
 			continue
 		}
 
-		statsList = append(statsList, database.BuildLanguageStatResponse(code, stat))
+		statsList = append(statsList, database.BuildLanguageStatResponse(code, stat, displayIn))
 	}
 
 	if len(statsList) == 0 {
@@ -78,5 +90,197 @@ func GetLanguageStats(c *gin.Context) {
 		return
 	}
 
-	HandleSuccess(c, statsList)
+	renderLanguageStats(c, format, statsList)
+}
+
+// renderLanguageStats writes language statistics in the negotiated format, with one NDJSON record per language.
+func renderLanguageStats(c *gin.Context, format responseFormat, stats []models.LanguageStatisticsReponse) {
+	renderResponse(c, format, stats, func() recordSet {
+		var set recordSet
+		for _, stat := range stats {
+			set.records = append(set.records, stat)
+		}
+		return set
+	})
+}
+
+// MARK: Field Coverage
+
+// GetLanguageCoverage handles GET /language-stats/:lang/coverage.
+// @Summary Get field completeness for a language
+// @Description Returns, for every data type of the language, the share of rows holding a non-null, non-empty value in each column,
+// @Description whether the language contract declares the column, and the average over the declared columns.
+// @Description Columns the contract references but the table lacks are marked as missing and count as 0% towards the average.
+// @Tags statistics
+// @Accept json
+// @Produce json
+// @Param lang path string true "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR" example(de)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
+// @Success 200 {object} models.LanguageCoverageResponse "Field completeness by data type"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the underlying data last changed"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid language code"
+// @Failure 404 {object} models.ErrorResponse "Language not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /api/v1/language-stats/{lang}/coverage [get]
+func GetLanguageCoverage(c *gin.Context) {
+	lang, ok := requireLanguage(c, c.Param("lang"))
+	if !ok {
+		return
+	}
+
+	if _, notModified := checkLanguageNotModified(c, lang); notModified {
+		return
+	}
+
+	dataTypes, err := database.GetLanguageDataTypes(lang)
+	if err != nil {
+		log.Printf("Error fetching data types for %s: %v", lang, err)
+		HandleError(c, http.StatusInternalServerError, "Failed to fetch language data types")
+		return
+	}
+
+	var contractFields, contractColumns map[string]map[string]bool
+	contract, hasContract := contractCache.Get(lang)
+	if hasContract {
+		contractFields, contractColumns = contract.Fields(), contract.Columns()
+	}
+
+	response := models.LanguageCoverageResponse{
+		Code:        lang,
+		HasContract: hasContract,
+		DataTypes:   make(map[string]models.DataTypeCoverage, len(dataTypes)),
+	}
+
+	for _, dataType := range dataTypes {
+		rows, filled, err := dbqueries.GetLanguageTableCoverage(lang, dataType)
+		if err != nil {
+			log.Printf("Error computing coverage for %s/%s: %v", lang, dataType, err)
+			HandleError(c, http.StatusInternalServerError, "Failed to compute field coverage")
+			return
+		}
+
+		response.DataTypes[dataType] = buildDataTypeCoverage(rows, filled, contractFields[dataType], contractColumns[dataType])
+	}
+
+	HandleSuccess(c, response)
+}
+
+// buildDataTypeCoverage turns value counts into percentages and compares the columns against the contract fields.
+// Columns the contract references but the table lacks are added with no values, so they lower the contract coverage.
+func buildDataTypeCoverage(rows int, filled map[string]int, contractFields, contractColumns map[string]bool) models.DataTypeCoverage {
+	coverage := models.DataTypeCoverage{
+		Rows:    rows,
+		Columns: make(map[string]models.ColumnCoverage, len(filled)),
+	}
+
+	var declaredTotal float64
+	var declaredCount int
+	for column, count := range filled {
+		percentage := 0.0
+		if rows > 0 {
+			percentage = math.Round(float64(count)/float64(rows)*10000) / 100
+		}
+
+		inContract := contractFields[column]
+		if inContract {
+			declaredTotal += percentage
+			declaredCount++
+		}
+
+		coverage.Columns[column] = models.ColumnCoverage{
+			Filled:     count,
+			Percentage: percentage,
+			InContract: inContract,
+		}
+	}
+
+	for column := range contractColumns {
+		_, inTable := filled[column]
+		isMeta := column == database.LexemeIDColumn || column == database.LastModifiedColumn
+		if inTable || isMeta {
+			continue
+		}
+
+		declaredCount++
+		coverage.Columns[column] = models.ColumnCoverage{
+			InContract: true,
+			Missing:    true,
+		}
+	}
+
+	if declaredCount > 0 {
+		average := math.Round(declaredTotal/float64(declaredCount)*100) / 100
+		coverage.ContractCoverage = &average
+	}
+
+	return coverage
+}
+
+// MARK: Statistics History
+
+// GetLanguageStatsHistory handles GET /language-stats/history?codes=de&from=2025-01-01.
+// @Summary Get the statistics history of languages
+// @Description Returns the entry counts per data type recorded after each data migration, for charting the growth of the dataset over time.
+// @Description Languages are named in the language requested via Accept-Language.
+// @Tags statistics
+// @Accept json
+// @Produce json,application/x-yaml,application/x-ndjson,application/msgpack,application/cbor
+// @Param codes query string false "Comma-separated list of language codes to filter (e.g., "fr,de,es")"
+// @Param format query string false "Response format, overriding the Accept header" Enums(json, yaml, ndjson, msgpack, cbor)
+// @Param Accept-Language header string false "Languages to give display names in (e.g., "de-CH, de;q=0.9")"
+// @Param from query string false "Only return snapshots recorded at or after this RFC 3339 timestamp or date" example(2025-01-01)
+// @Param to query string false "Only return snapshots recorded at or before this RFC 3339 timestamp or date" example(2025-12-31T23:59:59Z)
+// @Success 200 {object} models.LanguageStatsHistoryResponse "Statistics history by language"
+// @Failure 400 {object} models.ErrorResponse "Invalid language codes, time range or format"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /api/v1/language-stats/history [get]
+func GetLanguageStatsHistory(c *gin.Context) {
+	format, ok := negotiateFormat(c, documentFormats)
+	if !ok {
+		return
+	}
+
+	var codes []string
+	if codesParam := c.Query("codes"); codesParam != "" {
+		for _, code := range strings.Split(codesParam, ",") {
+			code = strings.ToLower(strings.TrimSpace(code))
+			if !database.IsValidLanguageTableCode(code) {
+				HandleError(c, http.StatusBadRequest, constants.InvalidLanguageCodesError)
+				return
+			}
+			codes = append(codes, code)
+		}
+	}
+
+	from, err := parseTimeParam(c, "from", constants.InvalidHistoryRangeError)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	to, err := parseTimeParam(c, "to", constants.InvalidHistoryRangeError)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	history, err := database.GetStatsHistory(codes, from, to, displayLanguage(c))
+	if err != nil {
+		log.Printf("Error fetching statistics history: %v", err)
+		HandleError(c, http.StatusInternalServerError, "Failed to fetch statistics history")
+		return
+	}
+
+	renderResponse(c, format, models.LanguageStatsHistoryResponse{
+		Languages: history,
+	}, func() recordSet {
+		var set recordSet
+		for _, language := range history {
+			set.records = append(set.records, language)
+		}
+		return set
+	})
 }
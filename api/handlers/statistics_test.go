@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/scribe-org/scribe-server/models"
+)
+
+func TestBuildDataTypeCoverageReportsMissingContractColumns(t *testing.T) {
+	filled := map[string]int{"singular": 4, "plural": 2, "extra": 1}
+	contractFields := map[string]bool{"singular": true, "plural": true, "genitive": true, "title": true}
+	contractColumns := map[string]bool{"singular": true, "plural": true, "genitive": true, "lexemeID": true}
+
+	coverage := buildDataTypeCoverage(4, filled, contractFields, contractColumns)
+
+	want := map[string]models.ColumnCoverage{
+		"singular": {Filled: 4, Percentage: 100, InContract: true},
+		"plural":   {Filled: 2, Percentage: 50, InContract: true},
+		"extra":    {Filled: 1, Percentage: 25},
+		"genitive": {InContract: true, Missing: true},
+	}
+	if len(coverage.Columns) != len(want) {
+		t.Errorf("columns = %v, want %v", coverage.Columns, want)
+	}
+	for column, expected := range want {
+		if got := coverage.Columns[column]; got != expected {
+			t.Errorf("column %s = %+v, want %+v", column, got, expected)
+		}
+	}
+
+	if coverage.ContractCoverage == nil || *coverage.ContractCoverage != 50 {
+		t.Errorf("contract coverage = %v, want 50", coverage.ContractCoverage)
+	}
+}
+
+func TestBuildDataTypeCoverageWithoutContract(t *testing.T) {
+	coverage := buildDataTypeCoverage(0, map[string]int{"singular": 0}, nil, nil)
+
+	if coverage.ContractCoverage != nil {
+		t.Errorf("contract coverage = %v, want none without a contract", *coverage.ContractCoverage)
+	}
+	if got := coverage.Columns["singular"]; got != (models.ColumnCoverage{}) {
+		t.Errorf("column singular = %+v, want no values", got)
+	}
+}
+
+// MARK: Statistics History
+
+// statsHistoryTestTables holds snapshots recorded after three migrations, the last of which added Dagbani.
+var statsHistoryTestTables = []string{
+	"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT)",
+	`CREATE TABLE language_stats_history (
+		recorded_at DATETIME, language_iso TEXT, data_type TEXT, row_count INTEGER
+	)`,
+	`INSERT INTO language_stats_history VALUES
+		('2025-01-01 00:00:00', 'de', 'nouns', 10), ('2025-01-01 00:00:00', 'de', 'verbs', 4),
+		('2025-02-01 00:00:00', 'de', 'nouns', 12), ('2025-02-01 00:00:00', 'de', 'verbs', 5),
+		('2025-03-01 00:00:00', 'de', 'nouns', 15), ('2025-03-01 00:00:00', 'dag', 'nouns', 2)`,
+}
+
+// getStatsHistory requests the statistics history and returns the recorded times by language.
+func getStatsHistory(t *testing.T, query string) (int, map[string][]string) {
+	t.Helper()
+
+	w := serveTestRequest(GetLanguageStatsHistory, nil, query, "")
+	if w.Code != http.StatusOK {
+		return w.Code, nil
+	}
+
+	var response models.LanguageStatsHistoryResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+
+	times := make(map[string][]string)
+	for _, history := range response.Languages {
+		for _, snapshot := range history.Snapshots {
+			times[history.Code] = append(times[history.Code], snapshot.RecordedAt)
+		}
+	}
+	return w.Code, times
+}
+
+func TestGetLanguageStatsHistory(t *testing.T) {
+	useTestDB(t, statsHistoryTestTables...)
+
+	tests := []struct {
+		query string
+		want  map[string][]string
+	}{
+		{"", map[string][]string{
+			"dag": {"2025-03-01T00:00:00Z"},
+			"de":  {"2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z", "2025-03-01T00:00:00Z"},
+		}},
+		{"codes=de", map[string][]string{"de": {"2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z", "2025-03-01T00:00:00Z"}}},
+		{"codes=de&from=2025-01-15&to=2025-02-15", map[string][]string{"de": {"2025-02-01T00:00:00Z"}}},
+		{"codes=fr", map[string][]string{}},
+	}
+
+	for _, tt := range tests {
+		status, got := getStatsHistory(t, tt.query)
+		if status != http.StatusOK || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: status %d, snapshots %v, want %v", tt.query, status, got, tt.want)
+		}
+	}
+
+	w := serveTestRequest(GetLanguageStatsHistory, nil, "codes=de&from=2025-01-31", "")
+	var response models.LanguageStatsHistoryResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]int{"nouns": 12, "verbs": 5}; !reflect.DeepEqual(response.Languages[0].Snapshots[0].DataTypes, want) {
+		t.Errorf("first snapshot = %v, want %v", response.Languages[0].Snapshots[0].DataTypes, want)
+	}
+}
+
+func TestGetLanguageStatsHistoryRejectsInvalidRequests(t *testing.T) {
+	useTestDB(t, statsHistoryTestTables...)
+
+	for _, query := range []string{"codes=de,d1", "from=yesterday", "to=2025-13-01"} {
+		if status, _ := getStatsHistory(t, query); status != http.StatusBadRequest {
+			t.Errorf("%q: status = %d, want 400", query, status)
+		}
+	}
+}
+
+func TestGetLanguageStatsHistoryBeforeFirstSnapshot(t *testing.T) {
+	useTestDB(t, "CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT)")
+
+	if status, got := getStatsHistory(t, ""); status != http.StatusOK || len(got) != 0 {
+		t.Errorf("status %d, snapshots %v, want an empty history", status, got)
+	}
+}
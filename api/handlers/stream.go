@@ -0,0 +1,398 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/dbqueries"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// streamBufferSize is the amount of encoded output collected before it is flushed to the client.
+const streamBufferSize = 32 * 1024
+
+// MARK: JSON Streaming
+
+// jsonStream writes a JSON document to the response piece by piece.
+// The response is sent chunked, so memory use does not grow with the document size.
+// The first write error is kept and all later writes are skipped.
+type jsonStream struct {
+	w       *bufio.Writer
+	flusher http.Flusher
+	err     error
+}
+
+// newJSONStream starts a 200 JSON response on c and returns a stream writing its body.
+func newJSONStream(c *gin.Context) *jsonStream {
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	return &jsonStream{
+		w:       bufio.NewWriterSize(c.Writer, streamBufferSize),
+		flusher: c.Writer,
+	}
+}
+
+// raw writes pre-encoded JSON text.
+func (s *jsonStream) raw(text string) {
+	if s.err != nil {
+		return
+	}
+	_, s.err = s.w.WriteString(text)
+}
+
+// value encodes and writes a single JSON value.
+func (s *jsonStream) value(v any) {
+	if s.err != nil {
+		return
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.bytes(encoded)
+}
+
+// bytes writes pre-encoded JSON held in a byte slice.
+func (s *jsonStream) bytes(encoded []byte) {
+	// Hand full buffers to the client as they fill up rather than growing them.
+	if s.w.Available() < len(encoded) {
+		s.flush()
+	}
+	if s.err == nil {
+		_, s.err = s.w.Write(encoded)
+	}
+}
+
+// field writes an object key followed by its value, preceded by a comma unless it is the first field.
+func (s *jsonStream) field(key string, v any, first bool) {
+	if !first {
+		s.raw(",")
+	}
+	s.value(key)
+	s.raw(":")
+	s.value(v)
+}
+
+// rows writes the remaining rows of a table as a JSON array of objects, or as a models.ColumnarRows object.
+// Each row is encoded straight from the scanned column values into a reused buffer.
+func (s *jsonStream) rows(rows *dbqueries.LanguageTableRows, layout rowLayout) {
+	encoder := newRowEncoder(rows.Columns(), layout)
+
+	if layout == layoutColumnar {
+		s.raw(`{"columns":`)
+		s.value(rows.Columns())
+		s.raw(`,"rows":`)
+	}
+
+	s.raw("[")
+	for i := 0; rows.Next(); i++ {
+		if i > 0 {
+			s.raw(",")
+		}
+		s.bytes(encoder.encode(rows))
+		if s.err != nil {
+			return
+		}
+	}
+	if s.err == nil {
+		s.err = rows.Err()
+	}
+	s.raw("]")
+
+	if layout == layoutColumnar {
+		s.raw("}")
+	}
+}
+
+// flush sends all buffered output to the client.
+func (s *jsonStream) flush() {
+	if s.err != nil {
+		return
+	}
+	if s.err = s.w.Flush(); s.err == nil {
+		s.flusher.Flush()
+	}
+}
+
+// MARK: Language Data Streaming
+
+// streamLanguageData writes a models.LanguageDataResponse for the given data types,
+// streaming the rows of each table straight from the database into the response.
+func streamLanguageData(c *gin.Context, req dataRequest, contract models.Contract, dataTypes []string) {
+	stream := newJSONStream(c)
+	stream.raw("{")
+	stream.field("language", req.lang, true)
+	stream.field("contract", contract, false)
+	stream.raw(`,"data":{`)
+
+	nextAfter := make(map[string]string)
+	deleted := make(map[string][]string)
+	written := 0
+
+	for _, dataType := range dataTypes {
+		rows, err := dbqueries.OpenLanguageTableRows(req.lang, dataType, req.tableOptions(dataType))
+		if err != nil {
+			log.Printf("Error fetching table data for %s/%s: %v", req.lang, dataType, err)
+			continue
+		}
+
+		if written > 0 {
+			stream.raw(",")
+		}
+		stream.value(dataType)
+		stream.raw(":")
+		stream.rows(rows, req.layout)
+		rows.Close()
+		written++
+
+		if stream.err != nil {
+			log.Printf("Error streaming table data for %s/%s: %v", req.lang, dataType, stream.err)
+			return
+		}
+		if next := rows.NextKey(); next != "" {
+			nextAfter[dataType] = next
+		}
+		if len(rows.Deleted) > 0 {
+			deleted[dataType] = rows.Deleted
+		}
+	}
+
+	stream.raw("}")
+	if len(deleted) > 0 {
+		stream.field("deleted", deleted, false)
+	}
+	if cursor := encodeCursor(nextAfter); cursor != "" {
+		stream.field("next_cursor", cursor, false)
+	}
+	stream.raw("}")
+	stream.flush()
+
+	if stream.err != nil {
+		log.Printf("Error streaming language data for %s: %v", req.lang, stream.err)
+	}
+}
+
+// streamLanguageDataType writes a models.LanguageDataTypeResponse, streaming the rows of the table into the response.
+func streamLanguageDataType(c *gin.Context, req dataRequest, contract models.Contract, dataType string, rows *dbqueries.LanguageTableRows) {
+	stream := newJSONStream(c)
+	stream.raw("{")
+	stream.field("language", req.lang, true)
+	stream.field("data_type", dataType, false)
+	stream.field("contract", contract, false)
+	stream.raw(`,"data":`)
+	stream.rows(rows, req.layout)
+
+	if len(rows.Deleted) > 0 {
+		stream.field("deleted", rows.Deleted, false)
+	}
+	if next := rows.NextKey(); next != "" {
+		stream.field("next_cursor", encodeCursor(map[string]string{dataType: next}), false)
+	}
+	stream.raw("}")
+	stream.flush()
+
+	if stream.err != nil {
+		log.Printf("Error streaming table data for %s/%s: %v", req.lang, dataType, stream.err)
+	}
+}
+
+// MARK: Record Streaming
+
+// deletedColumn marks the records of lexemes removed since the delta sync time in NDJSON and CSV responses.
+const deletedColumn = "deleted"
+
+// streamLanguageDataTypeRecords writes the rows of a data type as NDJSON lines or CSV rows.
+// Lexemes removed since the delta sync time come first, as records holding only their ID and deleted set to true.
+// A page's rows are read before the response starts so that its cursor can be sent in the X-Next-Cursor header.
+func streamLanguageDataTypeRecords(c *gin.Context, req dataRequest, format responseFormat, dataType string, rows *dbqueries.LanguageTableRows) {
+	columns := rows.Columns()
+	if len(rows.Deleted) > 0 {
+		columns = append(slices.Clone(columns), deletedColumn)
+	}
+
+	var page []map[string]any
+	if req.page.enabled {
+		for rows.Next() {
+			page = append(page, rows.Row())
+		}
+		if err := rows.Err(); err != nil {
+			log.Printf("Error reading table data for %s/%s: %v", req.lang, dataType, err)
+			HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguageData)
+			return
+		}
+		if next := rows.NextKey(); next != "" {
+			c.Header(nextCursorHeader, encodeCursor(map[string]string{dataType: next}))
+		}
+	}
+
+	stream := newRecordStream(c, format, columns)
+	for _, lexemeID := range rows.Deleted {
+		stream.write(map[string]any{database.LexemeIDColumn: lexemeID, deletedColumn: true})
+	}
+
+	if req.page.enabled {
+		for _, row := range page {
+			stream.write(row)
+		}
+	} else {
+		for rows.Next() && stream.err == nil {
+			stream.write(rows.Row())
+		}
+		if stream.err == nil {
+			stream.err = rows.Err()
+		}
+	}
+
+	if err := stream.close(); err != nil {
+		log.Printf("Error streaming table data for %s/%s: %v", req.lang, dataType, err)
+	}
+}
+
+// streamLanguageDataRecords writes the rows of the given data types as NDJSON lines, each holding its data type
+// next to its columns. The records of lexemes removed since the delta sync time precede the rows of their data type.
+// When paging, the pages are read before the response starts so that the cursor can be sent in the X-Next-Cursor header.
+func streamLanguageDataRecords(c *gin.Context, req dataRequest, dataTypes []string) {
+	var stream *recordStream
+	var page []map[string]any
+	emit := func(record map[string]any) { page = append(page, record) }
+	if !req.page.enabled {
+		stream = newRecordStream(c, formatNDJSON, nil)
+		emit = func(record map[string]any) { stream.write(record) }
+	}
+
+	nextAfter := make(map[string]string)
+	for _, dataType := range dataTypes {
+		rows, err := dbqueries.OpenLanguageTableRows(req.lang, dataType, req.tableOptions(dataType))
+		if err != nil {
+			log.Printf("Error fetching table data for %s/%s: %v", req.lang, dataType, err)
+			continue
+		}
+
+		for _, lexemeID := range rows.Deleted {
+			emit(map[string]any{"data_type": dataType, database.LexemeIDColumn: lexemeID, deletedColumn: true})
+		}
+		for rows.Next() && (stream == nil || stream.err == nil) {
+			record := rows.Row()
+			record["data_type"] = dataType
+			emit(record)
+		}
+		err = rows.Err()
+		rows.Close()
+
+		if err != nil {
+			log.Printf("Error reading table data for %s/%s: %v", req.lang, dataType, err)
+			if stream == nil {
+				HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingLanguageData)
+			}
+			return
+		}
+		if stream != nil && stream.err != nil {
+			break
+		}
+		if next := rows.NextKey(); next != "" {
+			nextAfter[dataType] = next
+		}
+	}
+
+	if stream == nil {
+		if cursor := encodeCursor(nextAfter); cursor != "" {
+			c.Header(nextCursorHeader, cursor)
+		}
+		stream = newRecordStream(c, formatNDJSON, nil)
+		for _, record := range page {
+			stream.write(record)
+		}
+	}
+
+	if err := stream.close(); err != nil {
+		log.Printf("Error streaming language data for %s: %v", req.lang, err)
+	}
+}
+
+// MARK: Language Data Collection
+
+// collectLanguageDataType reads a page of a data type into a models.LanguageDataTypeResponse,
+// for the formats that cannot be written piece by piece.
+func collectLanguageDataType(req dataRequest, contract models.Contract, dataType string, rows *dbqueries.LanguageTableRows) (models.LanguageDataTypeResponse, error) {
+	response := models.LanguageDataTypeResponse{
+		Language: req.lang,
+		DataType: dataType,
+		Contract: contract,
+		Deleted:  rows.Deleted,
+	}
+
+	if req.layout == layoutColumnar {
+		columnar := models.ColumnarRows{Columns: rows.Columns(), Rows: [][]any{}}
+		for rows.Next() {
+			columnar.Rows = append(columnar.Rows, rows.Values())
+		}
+		response.Data = columnar
+	} else {
+		data := []map[string]any{}
+		for rows.Next() {
+			data = append(data, rows.Row())
+		}
+		response.Data = data
+	}
+
+	if err := rows.Err(); err != nil {
+		return models.LanguageDataTypeResponse{}, err
+	}
+
+	if next := rows.NextKey(); next != "" {
+		response.NextCursor = encodeCursor(map[string]string{dataType: next})
+	}
+
+	return response, nil
+}
+
+// collectLanguageData reads a page of the given data types into a models.LanguageDataResponse,
+// for the formats that cannot be written piece by piece.
+func collectLanguageData(req dataRequest, contract models.Contract, dataTypes []string) (models.LanguageDataResponse, error) {
+	response := models.LanguageDataResponse{
+		Language: req.lang,
+		Contract: contract,
+		Data:     make(map[string]any),
+	}
+
+	nextAfter := make(map[string]string)
+	for _, dataType := range dataTypes {
+		rows, err := dbqueries.OpenLanguageTableRows(req.lang, dataType, req.tableOptions(dataType))
+		if err != nil {
+			log.Printf("Error fetching table data for %s/%s: %v", req.lang, dataType, err)
+			continue
+		}
+
+		page, err := collectLanguageDataType(req, contract, dataType, rows)
+		rows.Close()
+		if err != nil {
+			return models.LanguageDataResponse{}, fmt.Errorf("error reading table data for %s/%s: %w", req.lang, dataType, err)
+		}
+
+		response.Data[dataType] = page.Data
+		if len(page.Deleted) > 0 {
+			if response.Deleted == nil {
+				response.Deleted = make(map[string][]string)
+			}
+			response.Deleted[dataType] = page.Deleted
+		}
+		if next := rows.NextKey(); next != "" {
+			nextAfter[dataType] = next
+		}
+	}
+
+	response.NextCursor = encodeCursor(nextAfter)
+	return response, nil
+}
@@ -0,0 +1,428 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/dbqueries"
+	"github.com/scribe-org/scribe-server/api/validators"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// MARK: Translation Lookup
+
+// GetWordTranslation returns the translations of a single word from a source language into a target language.
+//
+// @Summary Translate a single word
+// @Description Returns the translation entries of one source word, nested like /api/v1/translations.
+// @Description With reverse=true the word is matched against the translation column instead, returning the source words that translate to it.
+// @Tags Translations
+// @Accept  json
+// @Produce  json,application/x-yaml,application/x-ndjson,text/csv,application/msgpack,application/cbor
+// @Param source path string true "Source language code (ISO 639-1 or ISO 639-3)" example(de)
+// @Param target path string true "Target language code (ISO 639-1 or ISO 639-3)" example(en)
+// @Param word path string true "Source word, or target word in reverse mode" example(Haus)
+// @Param reverse query bool false "Match the word against translations instead of source words"
+// @Param format query string false "Response format, overriding the Accept header" Enums(json, yaml, ndjson, csv, msgpack, cbor)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
+// @Success 200 {object} models.TranslationLookupResponse "Successfully looked up translations"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time the translation data was last migrated"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid language code, word, reverse flag or format"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
+// @Failure 404 {object} models.ErrorResponse "Translation data or word not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /api/v1/translations/{source}/{target}/{word} [get]
+func GetWordTranslation(c *gin.Context) {
+	sourceLang := c.Param("source")
+	targetLang := c.Param("target")
+	word := strings.TrimSpace(c.Param("word"))
+
+	if !validators.IsValidTranslationLangCode(targetLang) || !validators.IsValidTranslationLangCode(sourceLang) {
+		HandleError(c, http.StatusBadRequest, constants.InvalidTranslationLangCodeError)
+		return
+	}
+
+	if word == "" || utf8.RuneCountInString(word) > constants.MaxLookupWordLength {
+		HandleError(c, http.StatusBadRequest, constants.InvalidTranslationWordError)
+		return
+	}
+
+	var data dbqueries.TranslationData
+	reverse := false
+	if value := c.Query("reverse"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, constants.InvalidReverseError)
+			return
+		}
+		reverse = parsed
+	}
+
+	format, ok := negotiateFormat(c, tableFormats)
+	if !ok {
+		return
+	}
+
+	migratedAt, err := dbqueries.GetTranslationMigrationTime(targetLang, sourceLang)
+	if err == nil {
+		if checkTranslationNotModified(c, migratedAt) {
+			return
+		}
+		data, err = dbqueries.FindTranslations(targetLang, sourceLang, word, reverse, constants.MaxLookupRows)
+	}
+	if err != nil {
+		log.Printf("Error looking up %q in translation data for %s/%s: %v", word, targetLang, sourceLang, err)
+		if strings.Contains(err.Error(), "does not exist") {
+			HandleError(c, http.StatusNotFound, fmt.Sprintf("No translation data for '%s' from '%s'", targetLang, sourceLang))
+			return
+		}
+		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingTranslationData)
+		return
+	}
+
+	if len(data) == 0 {
+		HandleError(c, http.StatusNotFound, fmt.Sprintf("No translations for '%s' from '%s' to '%s'", word, sourceLang, targetLang))
+		return
+	}
+
+	renderResponse(c, format, models.TranslationLookupResponse{
+		TargetLang: targetLang,
+		SourceLang: sourceLang,
+		Word:       word,
+		Reverse:    reverse,
+		Data:       data,
+	}, func() recordSet { return translationRecords(models.TranslationDataResponse{Data: data}) })
+}
+
+// MARK: Translation Pairs
+
+// GetTranslationPairs lists the language pairs for which translation data is available.
+//
+// @Summary List translation language pairs
+// @Description Returns every source and target language pair with translation data, with its number of entries, entries per word type and last migration time.
+// @Description The counts are taken once per migration of the translation tables.
+// @Tags Translations
+// @Accept  json
+// @Produce  json,application/x-yaml,application/x-ndjson,application/msgpack,application/cbor
+// @Param format query string false "Response format, overriding the Accept header" Enums(json, yaml, ndjson, msgpack, cbor)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Param If-Modified-Since header string false "Last-Modified time of a cached copy"
+// @Success 200 {object} models.TranslationPairsResponse "Successfully listed translation pairs"
+// @Header 200 {string} ETag "Entity tag of the returned representation"
+// @Header 200 {string} Last-Modified "Time translation data was last migrated"
+// @Success 304 "Cached copy is still current"
+// @Failure 400 {object} models.ErrorResponse "Invalid format"
+// @Failure 406 {object} models.ErrorResponse "None of the accepted media types can be served"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /api/v1/translations/pairs [get]
+func GetTranslationPairs(c *gin.Context) {
+	format, ok := negotiateFormat(c, documentFormats)
+	if !ok {
+		return
+	}
+
+	version, err := translationPairs.get()
+	if err != nil {
+		log.Printf("Error fetching translation pairs: %v", err)
+		HandleError(c, http.StatusInternalServerError, constants.ErrorFetchingTranslationData)
+		return
+	}
+
+	if checkNotModified(c, computeETag(constants.APIVersion, version.tables), version.lastModified) {
+		return
+	}
+
+	renderResponse(c, format, models.TranslationPairsResponse{
+		Pairs: version.pairs,
+	}, func() recordSet { return translationPairRecords(version.pairs) })
+}
+
+// translationPairRecords turns translation pairs into one record each.
+func translationPairRecords(pairs []models.TranslationPair) recordSet {
+	set := recordSet{records: make([]any, len(pairs))}
+	for i, pair := range pairs {
+		set.records[i] = pair
+	}
+	return set
+}
+
+// translationPairsVersion is the list of translation pairs as of a migration of the translation tables.
+type translationPairsVersion struct {
+	pairs        []models.TranslationPair
+	tables       string    // flattened migration times of the translation tables
+	lastModified time.Time // latest migration time of the translation tables
+	checkedAt    time.Time
+}
+
+// translationPairsCache remembers the translation pairs, so that their rows are only counted again once
+// a translation table was migrated, added or dropped.
+type translationPairsCache struct {
+	mu             sync.Mutex
+	entry          translationPairsVersion
+	loaded         bool
+	recheck        time.Duration
+	migrationTimes func() (map[string]time.Time, error)
+	pairs          func(migrationTimes map[string]time.Time) ([]models.TranslationPair, error)
+}
+
+// translationPairs is the cache GetTranslationPairs serves from.
+var translationPairs = newTranslationPairsCache(versionRecheckInterval, database.GetTranslationMigrationTimes, database.GetTranslationPairs)
+
+// newTranslationPairsCache returns an empty cache that loads translation pairs with the given functions.
+func newTranslationPairsCache(
+	recheck time.Duration,
+	migrationTimes func() (map[string]time.Time, error),
+	pairs func(migrationTimes map[string]time.Time) ([]models.TranslationPair, error),
+) *translationPairsCache {
+	return &translationPairsCache{
+		recheck:        recheck,
+		migrationTimes: migrationTimes,
+		pairs:          pairs,
+	}
+}
+
+// get returns the translation pairs, querying the migration times of the translation tables at most once per
+// recheck interval and counting their rows only when the tables changed.
+func (pc *translationPairsCache) get() (translationPairsVersion, error) {
+	now := time.Now()
+
+	pc.mu.Lock()
+	entry, loaded := pc.entry, pc.loaded
+	pc.mu.Unlock()
+	if loaded && now.Sub(entry.checkedAt) < pc.recheck {
+		return entry, nil
+	}
+
+	migrationTimes, err := pc.migrationTimes()
+	if err != nil {
+		return translationPairsVersion{}, err
+	}
+
+	versions := make(map[string]string, len(migrationTimes))
+	var lastModified time.Time
+	for tableName, migratedAt := range migrationTimes {
+		versions[tableName] = migratedAt.UTC().Format(time.RFC3339)
+		if migratedAt.After(lastModified) {
+			lastModified = migratedAt
+		}
+	}
+
+	if tables := flattenVersions(versions); !loaded || tables != entry.tables {
+		pairs, err := pc.pairs(migrationTimes)
+		if err != nil {
+			return translationPairsVersion{}, err
+		}
+		entry = translationPairsVersion{pairs: pairs, tables: tables, lastModified: lastModified}
+	}
+	entry.checkedAt = now
+
+	pc.mu.Lock()
+	pc.entry, pc.loaded = entry, true
+	pc.mu.Unlock()
+
+	return entry, nil
+}
+
+// MARK: Pivot Translation
+
+// autoPivot is the pivot parameter value that lets the server choose the intermediate language.
+const autoPivot = "auto"
+
+// resolveTranslationSource checks that translation data exists for the requested language pair, falling back to
+// composing it through an intermediate language if the pair has no table and a pivot was requested.
+// It returns the intermediate language, empty for direct translation data, and when the data was last migrated.
+func resolveTranslationSource(req translationRequest) (string, time.Time, error) {
+	migratedAt, err := dbqueries.GetTranslationMigrationTime(req.targetLang, req.sourceLang)
+	if err == nil || req.pivot == "" || !strings.Contains(err.Error(), "does not exist") {
+		return "", migratedAt, err
+	}
+
+	pivotLang := req.pivot
+	if req.pivot == autoPivot {
+		pivotLang, err = dbqueries.FindPivotLanguage(req.targetLang, req.sourceLang)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if pivotLang == "" {
+			return "", time.Time{}, fmt.Errorf("no pivot language for %s from %s: translation table does not exist", req.targetLang, req.sourceLang)
+		}
+	}
+
+	firstMigratedAt, err := dbqueries.GetTranslationMigrationTime(pivotLang, req.sourceLang)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	secondMigratedAt, err := dbqueries.GetTranslationMigrationTime(req.targetLang, pivotLang)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	// The composed data is only as recent as its older table, so an unknown time of either leaves it unknown.
+	if firstMigratedAt.IsZero() || secondMigratedAt.IsZero() {
+		return pivotLang, time.Time{}, nil
+	}
+	if secondMigratedAt.After(firstMigratedAt) {
+		return pivotLang, secondMigratedAt, nil
+	}
+	return pivotLang, firstMigratedAt, nil
+}
+
+// MARK: Translation Requests
+
+// translationCursorKey is the key of the last served source word in translation cursors.
+const translationCursorKey = "word"
+
+// translationRequest holds the query parameters of a translation data request.
+type translationRequest struct {
+	// Language codes of the pair
+	sourceLang, targetLang string
+	// Intermediate language, "auto" or empty
+	pivot string
+	// Word type to filter by, empty for all word types
+	wordType string
+	// Paging parameters, with the last served word under translationCursorKey
+	page pagination
+	// Migration time of the client's copy, zero for a full download
+	since time.Time
+}
+
+// parseTranslationRequest reads and validates the query parameters of a translation data request.
+// It writes a 400 response and returns false if any of them is invalid.
+func parseTranslationRequest(c *gin.Context) (translationRequest, bool) {
+	req := translationRequest{
+		sourceLang: c.Query("source_lang"),
+		targetLang: c.Query("target_lang"),
+		pivot:      c.Query("pivot"),
+		wordType:   c.Query("wordType"),
+	}
+
+	if req.sourceLang == "" || req.targetLang == "" {
+		HandleError(c, http.StatusBadRequest, constants.EmptyTranslationCodeError)
+		return translationRequest{}, false
+	}
+
+	if !validators.IsValidTranslationLangCode(req.targetLang) || !validators.IsValidTranslationLangCode(req.sourceLang) {
+		HandleError(c, http.StatusBadRequest, constants.InvalidTranslationLangCodeError)
+		return translationRequest{}, false
+	}
+
+	if req.pivot != "" && req.pivot != autoPivot && !validators.IsValidTranslationLangCode(req.pivot) {
+		HandleError(c, http.StatusBadRequest, constants.InvalidPivotError)
+		return translationRequest{}, false
+	}
+
+	if utf8.RuneCountInString(req.wordType) > constants.MaxLookupWordLength {
+		HandleError(c, http.StatusBadRequest, constants.InvalidWordTypeError)
+		return translationRequest{}, false
+	}
+
+	var err error
+	if req.page, err = parsePagination(c); err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return translationRequest{}, false
+	}
+	if req.page.resumed && req.page.after[translationCursorKey] == "" {
+		HandleError(c, http.StatusBadRequest, constants.InvalidCursorError)
+		return translationRequest{}, false
+	}
+
+	if req.since, err = parseSince(c); err != nil {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return translationRequest{}, false
+	}
+
+	return req, true
+}
+
+// options returns the entry selection of the request.
+func (r translationRequest) options() dbqueries.TranslationOptions {
+	opts := dbqueries.TranslationOptions{
+		AfterWord: r.page.after[translationCursorKey],
+		WordType:  r.wordType,
+	}
+	if r.page.enabled {
+		opts.Limit = r.page.limit
+	}
+	return opts
+}
+
+// response wraps a page of translation data, with a cursor to the next page if nextWord is set.
+func (r translationRequest) response(data dbqueries.TranslationData, pivotLang string, migratedAt time.Time, nextWord string) models.TranslationDataResponse {
+	response := models.TranslationDataResponse{
+		TargetLang: r.targetLang,
+		SourceLang: r.sourceLang,
+		PivotLang:  pivotLang,
+		Data:       data,
+	}
+
+	if !migratedAt.IsZero() {
+		response.UpdatedAt = migratedAt.UTC().Format(time.RFC3339)
+	}
+	if nextWord != "" {
+		response.NextCursor = encodeCursor(map[string]string{translationCursorKey: nextWord})
+	}
+
+	return response
+}
+
+// translationColumns are the CSV columns of translation data; pivoted data adds pivotColumns.
+var (
+	translationColumns = []string{"word", "wordType", "wordOrder", "description", "translation"}
+	pivotColumns       = []string{"pivoted", "via"}
+)
+
+// renderTranslationData writes translation data in the negotiated format.
+func renderTranslationData(c *gin.Context, format responseFormat, response models.TranslationDataResponse) {
+	renderResponse(c, format, response, func() recordSet { return translationRecords(response) })
+}
+
+// translationRecords flattens translation data into one record per entry, ordered by word, word type and word order.
+func translationRecords(response models.TranslationDataResponse) recordSet {
+	set := recordSet{
+		columns:    translationColumns,
+		nextCursor: response.NextCursor,
+	}
+	if response.PivotLang != "" {
+		set.columns = slices.Concat(translationColumns, pivotColumns)
+	}
+
+	for _, word := range slices.Sorted(maps.Keys(response.Data)) {
+		wordTypes := response.Data[word]
+		for _, wordType := range slices.Sorted(maps.Keys(wordTypes)) {
+			entries := wordTypes[wordType]
+			for _, wordOrder := range slices.Sorted(maps.Keys(entries)) {
+				entry := entries[wordOrder]
+				record := map[string]any{
+					"word":        word,
+					"wordType":    wordType,
+					"wordOrder":   wordOrder,
+					"description": entry.Description,
+					"translation": entry.Translation,
+				}
+				if response.PivotLang != "" {
+					record["pivoted"] = entry.Pivoted
+					record["via"] = entry.Via
+				}
+				set.records = append(set.records, record)
+			}
+		}
+	}
+
+	return set
+}
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// translationTestTables holds German to English translation data.
+var translationTestTables = []string{
+	`CREATE TABLE TranslationDataENFromDE (
+		word TEXT, wordType TEXT, wordOrder TEXT, description TEXT, translation TEXT
+	)`,
+	`INSERT INTO TranslationDataENFromDE VALUES
+		('Haus', 'noun', '1', 'building', 'house'), ('Haus', 'noun', '2', 'family', 'house'), ('gehen', 'verb', '1', '', 'go')`,
+}
+
+// wordTranslationParams are the path parameters of a lookup of Haus from German into English.
+var wordTranslationParams = gin.Params{{Key: "source", Value: "de"}, {Key: "target", Value: "en"}, {Key: "word", Value: "Haus"}}
+
+// MARK: Formats
+
+func TestGetWordTranslationNegotiatesFormat(t *testing.T) {
+	useTestDB(t, translationTestTables...)
+
+	w := serveTestRequest(GetWordTranslation, wordTranslationParams, "", "text/csv")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/csv") {
+		t.Errorf("Content-Type = %q, want text/csv", got)
+	}
+
+	want := "word,wordType,wordOrder,description,translation\nHaus,noun,1,building,house\nHaus,noun,2,family,house\n"
+	if got := w.Body.String(); got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+
+	if w := serveTestRequest(GetWordTranslation, wordTranslationParams, "format=xml", ""); w.Code != http.StatusBadRequest {
+		t.Errorf("unknown format status = %d, want 400", w.Code)
+	}
+}
+
+// MARK: Conditional Requests
+
+func TestTranslationRoutesAnswerConditionalRequests(t *testing.T) {
+	useTestDB(t, translationTestTables...)
+
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+		params  gin.Params
+		query   string
+	}{
+		{"word translation", GetWordTranslation, wordTranslationParams, ""},
+		{"translation data", GetTranslationData, nil, "source_lang=de&target_lang=en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveTestRequest(tt.handler, tt.params, tt.query, "")
+			etag := w.Header().Get("ETag")
+			if w.Code != http.StatusOK || etag == "" || w.Header().Get("Last-Modified") == "" {
+				t.Fatalf("status = %d, ETag %q, Last-Modified %q", w.Code, etag, w.Header().Get("Last-Modified"))
+			}
+
+			c, w := newTestContext(tt.query, "")
+			c.Params = tt.params
+			c.Request.Header.Set("If-None-Match", etag)
+			tt.handler(c)
+			if w.Code != http.StatusNotModified {
+				t.Errorf("revalidation status = %d, want 304", w.Code)
+			}
+
+			// Another format is another representation with its own validator.
+			c, w = newTestContext(tt.query+"&format=yaml", "")
+			c.Params = tt.params
+			c.Request.Header.Set("If-None-Match", etag)
+			tt.handler(c)
+			if w.Code != http.StatusOK {
+				t.Errorf("revalidation in another format status = %d, want 200", w.Code)
+			}
+		})
+	}
+}
+
+// MARK: Translation Pairs
+
+func TestGetTranslationPairs(t *testing.T) {
+	useTestDB(t, translationTestTables...)
+
+	previous := translationPairs
+	translationPairs = newTranslationPairsCache(time.Hour, database.GetTranslationMigrationTimes, database.GetTranslationPairs)
+	t.Cleanup(func() { translationPairs = previous })
+
+	w := serveTestRequest(GetTranslationPairs, nil, "", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+
+	var response models.TranslationPairsResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Pairs) != 1 || response.Pairs[0].LastMigrated == nil {
+		t.Fatalf("pairs = %+v, want one pair with its migration time", response.Pairs)
+	}
+	pair := response.Pairs[0]
+	if pair.SourceLang != "de" || pair.TargetLang != "en" || pair.RowCount != 3 ||
+		!reflect.DeepEqual(pair.WordTypes, map[string]int{"noun": 2, "verb": 1}) {
+		t.Errorf("pair = %+v", pair)
+	}
+
+	etag := w.Header().Get("ETag")
+	c, w := newTestContext("", "")
+	c.Request.Header.Set("If-None-Match", etag)
+	GetTranslationPairs(c)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("revalidation status = %d, want 304", w.Code)
+	}
+}
+
+func TestTranslationPairsCacheCountsPerMigration(t *testing.T) {
+	migrationTimes := map[string]time.Time{"TranslationDataENFromDE": time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	var timeQueries, counts int
+
+	cache := newTranslationPairsCache(time.Hour,
+		func() (map[string]time.Time, error) {
+			timeQueries++
+			return migrationTimes, nil
+		},
+		func(map[string]time.Time) ([]models.TranslationPair, error) {
+			counts++
+			return []models.TranslationPair{}, nil
+		},
+	)
+
+	for range 3 {
+		if _, err := cache.get(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if timeQueries != 1 || counts != 1 {
+		t.Errorf("queried migration times %d and counted %d times within the recheck interval, want once each", timeQueries, counts)
+	}
+
+	// Once the interval passed, rows are only counted again after a table was migrated or dropped.
+	cache.recheck = 0
+	first, _ := cache.get()
+	if counts != 1 {
+		t.Errorf("counted %d times without a migration, want 1", counts)
+	}
+
+	migrationTimes = map[string]time.Time{"TranslationDataENFromDE": time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC)}
+	second, _ := cache.get()
+	migrationTimes = map[string]time.Time{}
+	third, _ := cache.get()
+	if counts != 3 {
+		t.Errorf("counted %d times after a migration and a dropped table, want 3", counts)
+	}
+	if first.tables == second.tables || second.tables == third.tables {
+		t.Error("versions of different migrations share a validator")
+	}
+	if !second.lastModified.Equal(time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("lastModified = %v, want the latest migration time", second.lastModified)
+	}
+}
+
+// MARK: Pivot Translation
+
+// pivotTestTables holds Swedish to English and English to Bengali translation data, but none from Swedish to Bengali.
+// The English word has several senses, whose order is only right if sorted numerically.
+var pivotTestTables = []string{
+	`CREATE TABLE TranslationDataENFromSV (
+		word TEXT, wordType TEXT, wordOrder TEXT, description TEXT, translation TEXT
+	)`,
+	`INSERT INTO TranslationDataENFromSV VALUES
+		('hus', 'noun', '1', 'byggnad', 'house'), ('gå', 'verb', '1', '', 'walk'), ('katt', 'noun', '1', '', 'cat')`,
+	`CREATE TABLE TranslationDataBNFromEN (
+		word TEXT, wordType TEXT, wordOrder TEXT, description TEXT, translation TEXT
+	)`,
+	`INSERT INTO TranslationDataBNFromEN VALUES
+		('house', 'noun', '10', '', 'ভবন'), ('house', 'noun', '2', '', 'বাড়ি'), ('walk', 'verb', '1', '', 'হাঁটা'), ('walk', 'noun', '1', '', 'ভ্রমণ')`,
+}
+
+// getTranslationData requests translation data and decodes the JSON response.
+func getTranslationData(t *testing.T, query string) (int, models.TranslationDataResponse) {
+	t.Helper()
+
+	w := serveTestRequest(GetTranslationData, nil, query, "")
+	var response models.TranslationDataResponse
+	if w.Code == http.StatusOK {
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return w.Code, response
+}
+
+func TestGetTranslationDataThroughPivot(t *testing.T) {
+	useTestDB(t, pivotTestTables...)
+
+	want := map[string]map[string]map[string]models.TranslationEntry{
+		"hus": {"noun": {"1": {Description: "byggnad", Translation: "বাড়ি", Pivoted: true, Via: "house"}}},
+		"gå":  {"verb": {"1": {Translation: "হাঁটা", Pivoted: true, Via: "walk"}}},
+	}
+
+	for _, pivot := range []string{"en", "auto"} {
+		t.Run(pivot, func(t *testing.T) {
+			status, response := getTranslationData(t, "source_lang=sv&target_lang=bn&pivot="+pivot)
+			if status != http.StatusOK {
+				t.Fatalf("status = %d", status)
+			}
+			if response.PivotLang != "en" {
+				t.Errorf("pivot_lang = %q, want en", response.PivotLang)
+			}
+			if !reflect.DeepEqual(response.Data, want) {
+				t.Errorf("data = %+v, want %+v", response.Data, want)
+			}
+		})
+	}
+}
+
+func TestGetTranslationDataWithoutPivot(t *testing.T) {
+	useTestDB(t, pivotTestTables...)
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"source_lang=sv&target_lang=bn", http.StatusNotFound},
+		{"source_lang=sv&target_lang=bn&pivot=de", http.StatusNotFound},
+		{"source_lang=bn&target_lang=sv&pivot=auto", http.StatusNotFound},
+		{"source_lang=sv&target_lang=bn&pivot=e1", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		if status, _ := getTranslationData(t, tt.query); status != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.query, status, tt.want)
+		}
+	}
+}
+
+// MARK: Paging and Delta Sync
+
+func TestGetTranslationDataPagesByWord(t *testing.T) {
+	useTestDB(t, translationTestTables...)
+
+	var pages [][]string
+	query := "source_lang=de&target_lang=en&limit=1"
+	for len(pages) <= 3 {
+		status, response := getTranslationData(t, query)
+		if status != http.StatusOK {
+			t.Fatalf("status = %d", status)
+		}
+
+		var words []string
+		for word := range response.Data {
+			words = append(words, word)
+		}
+		pages = append(pages, words)
+
+		if response.NextCursor == "" {
+			break
+		}
+		query = "source_lang=de&target_lang=en&limit=1&cursor=" + response.NextCursor
+	}
+
+	// Pages hold whole words, so both senses of Haus share a page.
+	if want := [][]string{{"Haus"}, {"gehen"}}; !reflect.DeepEqual(pages, want) {
+		t.Errorf("pages = %v, want %v", pages, want)
+	}
+
+	if status, _ := getTranslationData(t, "source_lang=de&target_lang=en&limit=1&cursor=invalid"); status != http.StatusBadRequest {
+		t.Errorf("invalid cursor status = %d, want 400", status)
+	}
+}
+
+func TestGetTranslationDataFiltersByWordType(t *testing.T) {
+	useTestDB(t, translationTestTables...)
+
+	status, response := getTranslationData(t, "source_lang=de&target_lang=en&wordType=verb")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d", status)
+	}
+	want := map[string]map[string]map[string]models.TranslationEntry{"gehen": {"verb": {"1": {Translation: "go"}}}}
+	if !reflect.DeepEqual(response.Data, want) {
+		t.Errorf("data = %+v, want %+v", response.Data, want)
+	}
+}
+
+func TestGetTranslationDataSince(t *testing.T) {
+	useTestDB(t, translationTestTables...)
+
+	status, response := getTranslationData(t, "source_lang=de&target_lang=en")
+	if status != http.StatusOK || response.UpdatedAt == "" {
+		t.Fatalf("status = %d, updated_at %q", status, response.UpdatedAt)
+	}
+
+	tests := []struct {
+		since     string
+		wantWords int
+	}{
+		{"2000-01-01", 2},
+		{response.UpdatedAt, 0},
+		{"2100-01-01T00:00:00Z", 0},
+	}
+
+	for _, tt := range tests {
+		status, response := getTranslationData(t, "source_lang=de&target_lang=en&since="+tt.since)
+		if status != http.StatusOK {
+			t.Fatalf("since %s: status = %d", tt.since, status)
+		}
+		if len(response.Data) != tt.wantWords || response.UpdatedAt == "" {
+			t.Errorf("since %s: %d words, updated_at %q, want %d words", tt.since, len(response.Data), response.UpdatedAt, tt.wantWords)
+		}
+	}
+
+	if status, _ := getTranslationData(t, "source_lang=de&target_lang=en&since=yesterday"); status != http.StatusBadRequest {
+		t.Errorf("invalid since status = %d, want 400", status)
+	}
+}
@@ -3,7 +3,11 @@
 // Package api provides API routing and middleware setup for Scribe Server.
 package api
 
-import "github.com/gin-gonic/gin"
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
 
 // SetupCORS adds CORS middleware for API access.
 func SetupCORS() gin.HandlerFunc {
@@ -25,3 +29,27 @@ func SetupCORS() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// SetupCompression compresses JSON and YAML responses with the best content coding the client accepts.
+// Supported codings are zstd, brotli and gzip.
+func SetupCompression() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Responses differ by Accept-Encoding, which shared caches need to know.
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"), supportedEncodings)
+		if encoding == "" || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+
+		writer := &compressWriter{ResponseWriter: c.Writer, encoding: encoding}
+		c.Writer = writer
+		defer func() {
+			writer.close()
+			c.Writer = writer.ResponseWriter
+		}()
+
+		c.Next()
+	}
+}
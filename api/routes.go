@@ -17,11 +17,21 @@ func SetupRoutes(r *gin.Engine) {
 		v1 := api.Group("/v1")
 		{
 			v1.GET("/data/:lang", handlers.GetLanguageData)
+			v1.GET("/data/:lang/:dataType", handlers.GetLanguageDataType)
 			v1.GET("/data-version/:lang", handlers.GetLanguageVersion)
+			v1.GET("/lookup/:lang", handlers.GetWordLookup)
+			v1.GET("/complete/:lang", handlers.GetCompletions)
+			v1.GET("/lexemes/:lexemeID", handlers.GetLexeme)
 			v1.GET("/languages", handlers.GetAvailableLanguages)
 			v1.GET("/contracts", handlers.GetContracts)
+			v1.GET("/contracts/:lang", handlers.GetContract)
+			v1.GET("/contracts/:lang/validate", handlers.GetContractValidation)
 			v1.GET("/language-stats", handlers.GetLanguageStats)
+			v1.GET("/language-stats/history", handlers.GetLanguageStatsHistory)
+			v1.GET("/language-stats/:lang/coverage", handlers.GetLanguageCoverage)
 			v1.GET("/translations", handlers.GetTranslationData)
+			v1.GET("/translations/pairs", handlers.GetTranslationPairs)
+			v1.GET("/translations/:source/:target/:word", handlers.GetWordTranslation)
 		}
 	}
 }
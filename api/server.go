@@ -11,8 +11,10 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/scribe-org/scribe-server/api/handlers"
 	"github.com/scribe-org/scribe-server/api/validators"
 	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/contracts"
 	"github.com/spf13/viper"
 
 	swaggerFiles "github.com/swaggo/files"
@@ -40,6 +42,9 @@ func HandleRequests() {
 		gin.SetMode(gin.DebugMode) // fallback
 	}
 
+	// Load contracts into memory and reload them whenever they are exported anew.
+	setupContracts()
+
 	// Create Gin router with default middleware (logger and recovery).
 	r := gin.Default()
 
@@ -68,6 +73,7 @@ func HandleRequests() {
 
 	// Add custom middleware.
 	r.Use(SetupCORS())
+	r.Use(SetupCompression())
 
 	// Setup API routes.
 	SetupRoutes(r)
@@ -82,6 +88,18 @@ func HandleRequests() {
 	startServer(r)
 }
 
+// MARK: Contracts
+
+// setupContracts loads the contracts directory into the cache served by the contract handlers and watches it for changes.
+func setupContracts() {
+	cache := contracts.NewCache(viper.GetString("contractsDir"))
+	if err := cache.Watch(); err != nil {
+		log.Printf("Warning: Could not watch contracts directory, changes require a restart: %v", err)
+	}
+	handlers.SetContractCache(cache)
+	log.Printf("📜 Loaded %d contracts from %s", len(cache.All()), cache.Dir())
+}
+
 // MARK: Swagger Documentation
 
 // setupSwagger configures the Swagger documentation endpoint.
@@ -144,15 +162,34 @@ func setupStaticFiles(r *gin.Engine) {
 			}
 
 			filePath := filepath.Join(sqlitePath, filename)
-			if _, err := os.Stat(filePath); os.IsNotExist(err) {
+			info, err := os.Stat(filePath)
+			if os.IsNotExist(err) {
 				c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
 				return
 			}
+
 			// Set headers to force download.
 			c.Header("Content-Description", "File Transfer")
 			c.Header("Content-Transfer-Encoding", "binary")
 			c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
 			c.Header("Content-Type", "application/x-sqlite3")
+
+			// Serve a precompressed sidecar (e.g. .sqlite.zst) if the client accepts its coding.
+			if err == nil {
+				if sidecarPath, sidecarInfo, encoding := findSidecar(filePath, info, c.GetHeader("Accept-Encoding")); sidecarPath != "" {
+					c.Header("Content-Encoding", encoding)
+					filePath, info = sidecarPath, sidecarInfo
+				}
+			}
+
+			// Conditional requests are answered by http.ServeContent using the ETag and modification time.
+			if err == nil {
+				if etag, err := fileETag(filePath, info); err == nil {
+					c.Header("ETag", etag)
+				} else {
+					log.Printf("Warning: could not compute ETag for %s: %v", filename, err)
+				}
+			}
 			c.File(filePath)
 			return
 		}
@@ -192,17 +229,28 @@ func startServer(r *gin.Engine) {
 		availableLanguages = []string{"unknown"}
 	}
 
-	// Initialize cached language validation map.
+	// Initialize cached language validation map and tag aliases.
 	validators.InitLanguageValidator(availableLanguages)
+	validators.SetLanguageAliases(viper.GetStringMapString("languageAliases"))
 
 	log.Printf("👀 Listening on port %s", hostPort)
 	log.Println("🚀 API Endpoints:")
 	log.Println("  ✅ GET /api/v1/languages                				- List available languages")
 	log.Println("  ✅ GET /api/v1/contracts[?lang_iso=xx]      			- Get contracts (optional language filter)")
-	log.Println("  ✅ GET /api/v1/data/:lang_iso       				- Get full language data with schema")
+	log.Println("  ✅ GET /api/v1/contracts/:lang_iso      			- Get the contract of a single language")
+	log.Println("  ✅ GET /api/v1/contracts/:lang_iso/validate		- Compare a contract with the database tables")
+	log.Println("  ✅ GET /api/v1/data/:lang_iso[?limit=n&cursor=c&since=t]	- Get full, paginated or changed language data with schema")
+	log.Println("  ✅ GET /api/v1/data/:lang_iso/:data_type			- Get data and schema for a single data type")
 	log.Println("  ✅ GET /api/v1/data-version/:lang_iso 				- Get version info for a language")
+	log.Println("  ✅ GET /api/v1/lookup/:lang_iso?word=Haus			- Look up a word across a language's data types")
+	log.Println("  ✅ GET /api/v1/complete/:lang_iso?prefix=ha		- Complete a prefix from noun and verb forms")
+	log.Println("  ✅ GET /api/v1/lexemes/:lexeme_id				- Find the rows of a Wikidata lexeme across all data")
 	log.Println("  ✅ GET /api/v1/language-stats?codes=fr,de         		- Get statistics for all or selected languages")
+	log.Println("  ✅ GET /api/v1/language-stats/history?codes=de&from=t	- Get statistics recorded after each migration")
+	log.Println("  ✅ GET /api/v1/language-stats/:lang_iso/coverage		- Get field completeness per data type")
 	log.Println("  ✅ GET /api/v1/translations?source_lang=es&target_lang=en  	- Get translation data of target from source")
+	log.Println("  ✅ GET /api/v1/translations/pairs				- List language pairs with translation data")
+	log.Println("  ✅ GET /api/v1/translations/:source/:target/:word[?reverse=true]	- Translate a single word, or find the words translating to it")
 	log.Printf("📊 Available languages: %v", availableLanguages)
 
 	log.Fatal(r.Run(hostPort))
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package validators
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	languageMatcher language.Matcher
+	matcherCodes    []string
+	languageAliases = map[string]string{}
+)
+
+// SetLanguageAliases configures tags that resolve to a fixed language code, e.g. "zh-hant" to "zh".
+// Aliases are matched case-insensitively and take precedence over tag matching.
+// This should be called once during server startup.
+func SetLanguageAliases(aliases map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	languageAliases = make(map[string]string, len(aliases))
+	for tag, code := range aliases {
+		languageAliases[normalizeTag(tag)] = strings.ToLower(code)
+	}
+}
+
+// buildLanguageMatcher prepares BCP-47 matching onto the supported languages.
+// It must be called with mu held for writing.
+func buildLanguageMatcher() {
+	langs := make([]string, 0, len(supportedLanguagesMap))
+	for lang := range supportedLanguagesMap {
+		langs = append(langs, lang)
+	}
+	// Sorted so that ties between equally close languages are broken consistently.
+	slices.Sort(langs)
+
+	matcherCodes = matcherCodes[:0]
+	var tags []language.Tag
+	for _, lang := range langs {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			continue
+		}
+		matcherCodes = append(matcherCodes, lang)
+		tags = append(tags, tag)
+	}
+
+	languageMatcher = nil
+	if len(tags) > 0 {
+		languageMatcher = language.NewMatcher(tags)
+	}
+}
+
+// ResolveLanguageTag maps a language code or BCP-47 tag such as "pt-BR", "nb-NO" or "zh-Hant"
+// onto a supported language code. Supported codes resolve to themselves, then configured aliases
+// are applied, and otherwise the closest supported language is chosen.
+// It returns false if no supported language is a reasonable match.
+func ResolveLanguageTag(tag string) (string, bool) {
+	if IsValidLanguageCode(tag) {
+		return tag, true
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if code, ok := languageAliases[normalizeTag(tag)]; ok && supportedLanguagesMap[code] {
+		return code, true
+	}
+
+	if languageMatcher == nil {
+		return "", false
+	}
+
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return "", false
+	}
+
+	_, index, confidence := languageMatcher.Match(parsed)
+	if confidence == language.No {
+		return "", false
+	}
+
+	return matcherCodes[index], true
+}
+
+// normalizeTag lowercases a tag and uses hyphens as subtag separators.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package validators
+
+import "testing"
+
+// MARK: Tag Resolution
+
+func TestResolveLanguageTag(t *testing.T) {
+	InitLanguageValidator([]string{"dag", "de", "nb", "pt", "sv", "zh"})
+	SetLanguageAliases(map[string]string{"zh-Hant": "zh", "sv-FI": "SV", "fr-CA": "fr"})
+	t.Cleanup(func() { SetLanguageAliases(nil) })
+
+	tests := []struct {
+		tag    string
+		want   string
+		wantOK bool
+	}{
+		{"de", "de", true},
+		{"dag", "dag", true},
+		{"pt-BR", "pt", true},
+		{"pt_br", "pt", true},
+		{"de-AT", "de", true},
+		{"nb-NO", "nb", true},
+		{"no", "nb", true},
+		{"ZH_hant", "zh", true},
+		{"sv-FI", "sv", true},
+		// Aliases onto languages that are not served are ignored.
+		{"fr-CA", "", false},
+		{"fr", "", false},
+		{"en-US", "", false},
+		{"not a tag", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ResolveLanguageTag(tt.tag)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ResolveLanguageTag(%q) = %q, %v, want %q, %v", tt.tag, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestResolveLanguageTagWithoutLanguages(t *testing.T) {
+	InitLanguageValidator(nil)
+
+	if got, ok := ResolveLanguageTag("de-DE"); ok {
+		t.Errorf("ResolveLanguageTag(de-DE) = %q without served languages", got)
+	}
+}
@@ -25,12 +25,13 @@ func InitLanguageValidator(langs []string) {
 	for _, lang := range langs {
 		supportedLanguagesMap[lang] = true
 	}
+	buildLanguageMatcher()
 }
 
-// IsValidLanguageCode checks if the language code is a valid ISO 639-1 code
+// IsValidLanguageCode checks if the language code is a valid ISO 639-1 or ISO 639-3 code
 // and that it is among the supported languages.
 func IsValidLanguageCode(lang string) bool {
-	if len(lang) != 2 || lang != strings.ToLower(lang) {
+	if len(lang) < 2 || len(lang) > 3 || lang != strings.ToLower(lang) {
 		return false
 	}
 
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package validators
+
+import "testing"
+
+// MARK: Language Codes
+
+func TestIsValidLanguageCode(t *testing.T) {
+	InitLanguageValidator([]string{"de", "dag"})
+
+	tests := []struct {
+		lang string
+		want bool
+	}{
+		{"de", true},
+		{"dag", true},
+		{"fr", false},
+		{"DAG", false},
+		{"d", false},
+		{"dagb", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidLanguageCode(tt.lang); got != tt.want {
+			t.Errorf("IsValidLanguageCode(%q) = %v, want %v", tt.lang, got, tt.want)
+		}
+	}
+
+	if got := SanitizeLanguageCode("dag"); got != "DAG" {
+		t.Errorf("SanitizeLanguageCode(dag) = %q, want DAG", got)
+	}
+}
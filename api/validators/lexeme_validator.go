@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package validators
+
+import "regexp"
+
+// lexemeIDPattern matches Wikidata lexeme IDs such as L9837.
+var lexemeIDPattern = regexp.MustCompile(`^L[1-9][0-9]{0,9}$`)
+
+// IsValidLexemeID checks if an ID is a well-formed Wikidata lexeme ID.
+func IsValidLexemeID(lexemeID string) bool {
+	return lexemeIDPattern.MatchString(lexemeID)
+}
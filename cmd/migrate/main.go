@@ -16,14 +16,47 @@ func main() {
 	}
 
 	// MariaDB connection setup with config.
-	mariaDB, err := mariaDB.SetupMariaDB(config.Database)
+	db, err := mariaDB.SetupMariaDB(config.Database)
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer mariaDB.Close()
+	defer db.Close()
+
+	// Ensure the tables tracking removed and changed rows, completions, lookup forms and statistics exist.
+	if err := mariaDB.CreateTombstonesTable(db); err != nil {
+		log.Fatal(err)
+	}
+	if err := mariaDB.CreateUpsertsTable(db); err != nil {
+		log.Fatal(err)
+	}
+	if err := mariaDB.CreateCompletionsTable(db); err != nil {
+		log.Fatal(err)
+	}
+	if err := mariaDB.CreateLookupFormsTable(db); err != nil {
+		log.Fatal(err)
+	}
+	if err := mariaDB.CreateStatsHistoryTable(db); err != nil {
+		log.Fatal(err)
+	}
 
 	// Process SQLite files.
-	if err := sqlite.ProcessSQLiteFiles(mariaDB); err != nil {
+	tables, err := sqlite.ProcessSQLiteFiles(db)
+	if err != nil {
 		log.Fatal(err)
 	}
+
+	// Rebuild the autocomplete index for migrated noun and verb tables and drop entries of removed ones.
+	if err := mariaDB.RefreshCompletionIndex(db, tables); err != nil {
+		log.Printf("Warning: Failed to refresh completion index: %v", err)
+	}
+
+	// Rebuild the word lookup index for every migrated table and drop entries of removed ones.
+	if err := mariaDB.RefreshLookupIndex(db, tables); err != nil {
+		log.Printf("Warning: Failed to refresh lookup index: %v", err)
+	}
+
+	// Keep a snapshot of the migrated dataset's size for the statistics history.
+	if err := mariaDB.RecordStatsSnapshot(db); err != nil {
+		log.Printf("Warning: Failed to record statistics snapshot: %v", err)
+	}
 }
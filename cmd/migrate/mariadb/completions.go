@@ -0,0 +1,290 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mariadb
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/scribe-org/scribe-server/cmd/migrate/types"
+)
+
+// completionsTable holds the sorted prefix index used by Scribe-Server's autocomplete endpoint.
+const completionsTable = "language_completions"
+
+// maxCompletionTermLength is the longest term, in characters, that fits the indexed term column.
+const maxCompletionTermLength = 191
+
+// completionDataTypes are the data types whose lemmas and forms are offered as completions.
+var completionDataTypes = []string{"nouns", "verbs"}
+
+// nonFormColumns are text columns of data tables that describe a lexeme rather than hold one of its forms.
+var nonFormColumns = []string{"lexemeID", "lastModified", "gender", "auxiliaryVerb"}
+
+// languageTablePattern splits language data table names such as ENLanguageDataNounsScribe.
+var languageTablePattern = regexp.MustCompile(`^([A-Z]+)LanguageData([A-Za-z]+)Scribe$`)
+
+// MARK: Table Creation
+
+// CreateCompletionsTable creates the completion index table if it does not already exist.
+// Terms are stored lowercased in a binary collated column so prefixes map to index range scans.
+func CreateCompletionsTable(db *sql.DB) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			language_iso VARCHAR(8) NOT NULL,
+			term_key VARCHAR(%d) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+			term VARCHAR(%d) NOT NULL,
+			data_type VARCHAR(64) NOT NULL,
+			lexeme_id VARCHAR(32) NOT NULL,
+			PRIMARY KEY (language_iso, term_key, data_type, lexeme_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`, completionsTable, maxCompletionTermLength, maxCompletionTermLength)
+
+	if _, err := db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create %s table: %v", completionsTable, err)
+	}
+
+	return nil
+}
+
+// MARK: Index Building
+
+// indexSource is a language data table whose forms feed an index, such as the completion or lookup index.
+type indexSource struct {
+	table    types.MigratedTable
+	langISO  string
+	dataType string
+}
+
+// indexSources returns the tables of a migration run with one of the given data types, or of any data type if none are given.
+func indexSources(tables []types.MigratedTable, dataTypes []string) []indexSource {
+	var sources []indexSource
+	for _, table := range tables {
+		matches := languageTablePattern.FindStringSubmatch(table.Name)
+		if matches == nil {
+			continue
+		}
+
+		dataType := strings.ToLower(matches[2])
+		if len(dataTypes) == 0 || slices.Contains(dataTypes, dataType) {
+			sources = append(sources, indexSource{table: table, langISO: strings.ToLower(matches[1]), dataType: dataType})
+		}
+	}
+
+	return sources
+}
+
+// lexemeFormColumns returns the text columns of a table that hold forms of its lexemes.
+func lexemeFormColumns(tableSchema *types.TableSchema) []string {
+	var columns []string
+	for i, column := range tableSchema.ColumnNames {
+		if !slices.Contains(nonFormColumns, column) && tableSchema.ColumnTypes[i] == "TEXT" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// RefreshCompletionIndex brings the completion index in line with the tables of a migration run.
+// Entries of dropped tables are removed and those of freshly migrated tables rebuilt, in a single transaction.
+// Tables whose migration failed keep their previous entries, as their previous data is kept as well.
+func RefreshCompletionIndex(db *sql.DB, tables []types.MigratedTable) error {
+	sources := indexSources(tables, completionDataTypes)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %v", err)
+	}
+
+	var committed bool
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Printf("Error rolling back transaction: %v", err)
+			}
+		}
+	}()
+
+	if err := pruneIndex(tx, completionsTable); err != nil {
+		return err
+	}
+
+	for _, source := range sources {
+		if !source.table.Migrated {
+			continue
+		}
+		if err := rebuildCompletionIndex(db, tx, source); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	committed = true
+
+	return nil
+}
+
+// pruneIndex deletes the entries of an index whose language data table no longer exists in MariaDB.
+// Tables are not pruned merely for missing from the migration run, as a file that could not be read
+// leaves its tables and their entries in place.
+func pruneIndex(tx *sql.Tx, indexTable string) error {
+	existing, err := existingLanguageDataTypes(tx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(fmt.Sprintf("SELECT DISTINCT language_iso, data_type FROM `%s`", indexTable))
+	if err != nil {
+		return fmt.Errorf("failed to select indexed tables of %s: %v", indexTable, err)
+	}
+
+	var stale [][2]string
+	for rows.Next() {
+		var pair [2]string
+		if err := rows.Scan(&pair[0], &pair[1]); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan indexed table: %v", err)
+		}
+		if !existing[pair] {
+			stale = append(stale, pair)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating indexed tables: %v", err)
+	}
+
+	for _, pair := range stale {
+		result, err := tx.Exec(fmt.Sprintf("DELETE FROM `%s` WHERE language_iso = ? AND data_type = ?", indexTable), pair[0], pair[1])
+		if err != nil {
+			return fmt.Errorf("failed to remove stale entries from %s: %v", indexTable, err)
+		}
+		if removed, err := result.RowsAffected(); err == nil {
+			log.Printf("Removed %d entries of the dropped %s %s table from %s", removed, pair[0], pair[1], indexTable)
+		}
+	}
+
+	return nil
+}
+
+// existingLanguageDataTypes returns the lowercase language and data type of every language data table in MariaDB.
+func existingLanguageDataTypes(tx *sql.Tx) (map[[2]string]bool, error) {
+	rows, err := tx.Query(`
+		SELECT TABLE_NAME
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = DATABASE()
+		AND TABLE_NAME LIKE '%LanguageData%Scribe'
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list language data tables: %v", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[[2]string]bool)
+	for rows.Next() {
+		var tableName string
+		if err := rows.Scan(&tableName); err != nil {
+			return nil, fmt.Errorf("failed to scan table name: %v", err)
+		}
+		if matches := languageTablePattern.FindStringSubmatch(tableName); matches != nil {
+			existing[[2]string{strings.ToLower(matches[1]), strings.ToLower(matches[2])}] = true
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating language data tables: %v", err)
+	}
+
+	return existing, nil
+}
+
+// rebuildCompletionIndex replaces the completion entries of a freshly migrated noun or verb table within a transaction.
+// Forms are read outside the transaction, which only writes to the index.
+func rebuildCompletionIndex(db *sql.DB, tx *sql.Tx, source indexSource) error {
+	tableName := source.table.Name
+	tableSchema := source.table.Schema
+	if tableSchema == nil || !slices.Contains(tableSchema.ColumnNames, lexemeIDColumn) {
+		return nil
+	}
+
+	formColumns := lexemeFormColumns(tableSchema)
+
+	if _, err := tx.Exec(fmt.Sprintf("DELETE FROM `%s` WHERE language_iso = ? AND data_type = ?", completionsTable), source.langISO, source.dataType); err != nil {
+		return fmt.Errorf("failed to clear completions for %s: %v", tableName, err)
+	}
+	if len(formColumns) == 0 {
+		return nil
+	}
+
+	rows, err := db.Query(fmt.Sprintf("SELECT `%s`, `%s` FROM `%s`",
+		lexemeIDColumn, strings.Join(formColumns, "`, `"), tableName))
+	if err != nil {
+		return fmt.Errorf("failed to select forms from %s: %v", tableName, err)
+	}
+	defer rows.Close()
+
+	stmt, err := tx.Prepare(fmt.Sprintf(
+		"INSERT IGNORE INTO `%s` (language_iso, term_key, term, data_type, lexeme_id) VALUES (?, ?, ?, ?, ?)",
+		completionsTable,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to prepare completion insert: %v", err)
+	}
+	defer stmt.Close()
+
+	count, err := insertCompletions(rows, stmt, len(formColumns), source.langISO, source.dataType)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Indexed %d completion terms for table %s", count, tableName)
+	return nil
+}
+
+// insertCompletions reads the lexeme ID and forms of each row and inserts one entry per distinct form.
+func insertCompletions(rows *sql.Rows, stmt *sql.Stmt, formCount int, langISO, dataType string) (int, error) {
+	count := 0
+	values := make([]sql.NullString, formCount+1)
+	scanArgs := make([]any, len(values))
+	for i := range values {
+		scanArgs[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(scanArgs...); err != nil {
+			return count, fmt.Errorf("failed to scan row: %v", err)
+		}
+
+		lexemeID := values[0].String
+		if lexemeID == "" {
+			continue
+		}
+
+		seen := make(map[string]bool, formCount)
+		for _, form := range values[1:] {
+			term := strings.TrimSpace(form.String)
+			if !form.Valid || term == "" || seen[term] || utf8.RuneCountInString(term) > maxCompletionTermLength {
+				continue
+			}
+			seen[term] = true
+
+			if _, err := stmt.Exec(langISO, strings.ToLower(term), term, dataType, lexemeID); err != nil {
+				return count, fmt.Errorf("failed to insert completion %q: %v", term, err)
+			}
+			count++
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return count, fmt.Errorf("error iterating rows: %v", err)
+	}
+
+	return count, nil
+}
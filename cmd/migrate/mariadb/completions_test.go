@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mariadb
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/scribe-org/scribe-server/cmd/migrate/types"
+	"github.com/scribe-org/scribe-server/internal/testdb"
+)
+
+// indexedTables returns the language and data type pairs an index holds entries for, sorted.
+func indexedTables(t *testing.T, db *sql.DB, indexTable string) []string {
+	t.Helper()
+
+	rows, err := db.Query(fmt.Sprintf("SELECT DISTINCT language_iso || '/' || data_type AS pair FROM %s ORDER BY pair", indexTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	pairs := []string{}
+	for rows.Next() {
+		var pair string
+		if err := rows.Scan(&pair); err != nil {
+			t.Fatal(err)
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+// MARK: Pruning
+
+func TestRefreshIndexesKeepEntriesOfUnreadFiles(t *testing.T) {
+	// The German pack could not be read in this run, and the French noun table was dropped since the last one.
+	db := testdb.Open(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, singular TEXT)",
+		"CREATE TABLE DELanguageDataEmojiKeywordsScribe (word TEXT, emoji TEXT)",
+		fmt.Sprintf("CREATE TABLE %s (language_iso TEXT, term_key TEXT, term TEXT, data_type TEXT, lexeme_id TEXT)", completionsTable),
+		fmt.Sprintf(`INSERT INTO %s VALUES
+			('de', 'haus', 'Haus', 'nouns', 'L1'), ('de', 'gehen', 'gehen', 'verbs', 'L7'), ('fr', 'maison', 'maison', 'nouns', 'L9')`, completionsTable),
+		fmt.Sprintf("CREATE TABLE %s (language_iso TEXT, form TEXT, data_type TEXT, row_id INTEGER)", lookupFormsTable),
+		fmt.Sprintf(`INSERT INTO %s VALUES
+			('de', 'Haus', 'nouns', 1), ('de', 'Herz', 'emojikeywords', 1), ('fr', 'maison', 'nouns', 1)`, lookupFormsTable),
+	)
+
+	for _, tables := range [][]types.MigratedTable{nil, {}} {
+		if err := RefreshCompletionIndex(db, tables); err != nil {
+			t.Fatal(err)
+		}
+		if err := RefreshLookupIndex(db, tables); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := indexedTables(t, db, completionsTable), []string{"de/nouns"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("completions cover %v, want %v", got, want)
+	}
+	if got, want := indexedTables(t, db, lookupFormsTable), []string{"de/emojikeywords", "de/nouns"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("lookup forms cover %v, want %v", got, want)
+	}
+}
+
+func TestRefreshIndexesOfEmptyDatabase(t *testing.T) {
+	db := testdb.Open(t,
+		fmt.Sprintf("CREATE TABLE %s (language_iso TEXT, term_key TEXT, term TEXT, data_type TEXT, lexeme_id TEXT)", completionsTable),
+	)
+
+	if err := RefreshCompletionIndex(db, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := indexedTables(t, db, completionsTable); len(got) != 0 {
+		t.Errorf("completions cover %v, want none", got)
+	}
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mariadb
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/scribe-org/scribe-server/cmd/migrate/types"
+)
+
+// lookupFormsTable holds the form index used by Scribe-Server's word lookup endpoint.
+const lookupFormsTable = "language_lookup_forms"
+
+// maxLookupFormLength is the longest form, in characters, that fits the indexed form column.
+const maxLookupFormLength = 191
+
+// MARK: Table Creation
+
+// CreateLookupFormsTable creates the lookup index table if it does not already exist.
+// Forms are compared with the case insensitive table collation, so lookups ignore case like the data tables do.
+func CreateLookupFormsTable(db *sql.DB) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			language_iso VARCHAR(8) NOT NULL,
+			form VARCHAR(%d) NOT NULL,
+			data_type VARCHAR(64) NOT NULL,
+			row_id BIGINT UNSIGNED NOT NULL,
+			PRIMARY KEY (language_iso, form, data_type, row_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`, lookupFormsTable, maxLookupFormLength)
+
+	if _, err := db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create %s table: %v", lookupFormsTable, err)
+	}
+
+	return nil
+}
+
+// MARK: Index Building
+
+// RefreshLookupIndex brings the lookup index in line with the tables of a migration run.
+// Entries of dropped tables are removed and those of freshly migrated tables rebuilt, in a single transaction.
+// Tables whose migration failed keep their previous entries, as their previous data is kept as well.
+func RefreshLookupIndex(db *sql.DB, tables []types.MigratedTable) error {
+	sources := indexSources(tables, nil)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %v", err)
+	}
+
+	var committed bool
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Printf("Error rolling back transaction: %v", err)
+			}
+		}
+	}()
+
+	if err := pruneIndex(tx, lookupFormsTable); err != nil {
+		return err
+	}
+
+	for _, source := range sources {
+		if !source.table.Migrated {
+			continue
+		}
+		if err := rebuildLookupIndex(db, tx, source); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	committed = true
+
+	return nil
+}
+
+// rebuildLookupIndex replaces the lookup entries of a freshly migrated table within a transaction.
+// Entries reference rows by their row ID, which every migrated table has. Forms are read outside the transaction.
+func rebuildLookupIndex(db *sql.DB, tx *sql.Tx, source indexSource) error {
+	tableName := source.table.Name
+	if source.table.Schema == nil {
+		return nil
+	}
+
+	if _, err := tx.Exec(fmt.Sprintf("DELETE FROM `%s` WHERE language_iso = ? AND data_type = ?", lookupFormsTable), source.langISO, source.dataType); err != nil {
+		return fmt.Errorf("failed to clear lookup forms for %s: %v", tableName, err)
+	}
+
+	formColumns := lexemeFormColumns(source.table.Schema)
+	if len(formColumns) == 0 {
+		return nil
+	}
+
+	rows, err := db.Query(fmt.Sprintf("SELECT `rowID`, `%s` FROM `%s`", strings.Join(formColumns, "`, `"), tableName))
+	if err != nil {
+		return fmt.Errorf("failed to select forms from %s: %v", tableName, err)
+	}
+	defer rows.Close()
+
+	stmt, err := tx.Prepare(fmt.Sprintf(
+		"INSERT IGNORE INTO `%s` (language_iso, form, data_type, row_id) VALUES (?, ?, ?, ?)",
+		lookupFormsTable,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to prepare lookup form insert: %v", err)
+	}
+	defer stmt.Close()
+
+	count, err := insertLookupForms(rows, stmt, len(formColumns), source.langISO, source.dataType)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Indexed %d lookup forms for table %s", count, tableName)
+	return nil
+}
+
+// insertLookupForms reads the row ID and forms of each row and inserts one entry per distinct form.
+func insertLookupForms(rows *sql.Rows, stmt *sql.Stmt, formCount int, langISO, dataType string) (int, error) {
+	count := 0
+	var rowID int64
+	forms := make([]sql.NullString, formCount)
+	scanArgs := make([]any, formCount+1)
+	scanArgs[0] = &rowID
+	for i := range forms {
+		scanArgs[i+1] = &forms[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(scanArgs...); err != nil {
+			return count, fmt.Errorf("failed to scan row: %v", err)
+		}
+
+		seen := make(map[string]bool, formCount)
+		for _, form := range forms {
+			term := strings.TrimSpace(form.String)
+			if !form.Valid || term == "" || seen[term] || utf8.RuneCountInString(term) > maxLookupFormLength {
+				continue
+			}
+			seen[term] = true
+
+			if _, err := stmt.Exec(langISO, term, dataType, rowID); err != nil {
+				return count, fmt.Errorf("failed to insert lookup form %q: %v", term, err)
+			}
+			count++
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return count, fmt.Errorf("error iterating rows: %v", err)
+	}
+
+	return count, nil
+}
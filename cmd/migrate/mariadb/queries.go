@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/scribe-org/scribe-server/cmd/migrate/schema"
 	"github.com/scribe-org/scribe-server/cmd/migrate/types"
@@ -59,23 +60,26 @@ func generateMariaTableName(langCode, tableName string) string {
 // MARK: Migration Core
 
 // MigrateTable migrates a single table from SQLite to MariaDB.
-func MigrateTable(sqlite *sql.DB, mariaDB *sql.DB, langCode, tableName string) error {
+// The returned table names the MariaDB table even if the migration failed, in which case the previous table is kept.
+func MigrateTable(sqlite *sql.DB, mariaDB *sql.DB, langCode, tableName string) (types.MigratedTable, error) {
 	log.Printf("Migrating table %s for language %s", tableName, langCode)
 
+	// Generate table names.
+	mariaTableName := generateMariaTableName(langCode, tableName)
+	backupTableName := mariaTableName + "Old"
+	table := types.MigratedTable{Name: mariaTableName}
+
 	// Get table schema.
 	tableSchema, err := schema.GetTableSchema(sqlite, tableName)
 	if err != nil {
-		return fmt.Errorf("failed to get schema: %v", err)
+		return table, fmt.Errorf("failed to get schema: %v", err)
 	}
-
-	// Generate table names.
-	mariaTableName := generateMariaTableName(langCode, tableName)
-	backupTableName := mariaTableName + "Old"
+	table.Schema = tableSchema
 
 	// Check if table exists and rename it to backup.
 	exists, err := tableExists(mariaDB, mariaTableName)
 	if err != nil {
-		return fmt.Errorf("failed to check table existence: %v", err)
+		return table, fmt.Errorf("failed to check table existence: %v", err)
 	}
 
 	if exists {
@@ -84,7 +88,7 @@ func MigrateTable(sqlite *sql.DB, mariaDB *sql.DB, langCode, tableName string) e
 
 		// Rename existing table to backup.
 		if _, err := mariaDB.Exec(fmt.Sprintf("RENAME TABLE `%s` TO `%s`", mariaTableName, backupTableName)); err != nil {
-			return fmt.Errorf("failed to rename existing table: %v", err)
+			return table, fmt.Errorf("failed to rename existing table: %v", err)
 		}
 		log.Printf("Existing table renamed to %s", backupTableName)
 	}
@@ -95,10 +99,10 @@ func MigrateTable(sqlite *sql.DB, mariaDB *sql.DB, langCode, tableName string) e
 		// If creation fails and we had a backup, restore it.
 		if exists {
 			if _, restoreErr := mariaDB.Exec(fmt.Sprintf("RENAME TABLE `%s` TO `%s`", backupTableName, mariaTableName)); restoreErr != nil {
-				return fmt.Errorf("failed to create table and restore backup: original error: %v, restore error: %v", err, restoreErr)
+				return table, fmt.Errorf("failed to create table and restore backup: original error: %v, restore error: %v", err, restoreErr)
 			}
 		}
-		return fmt.Errorf("failed to create table: %v", err)
+		return table, fmt.Errorf("failed to create table: %v", err)
 	}
 
 	// Perform the data migration.
@@ -109,10 +113,18 @@ func MigrateTable(sqlite *sql.DB, mariaDB *sql.DB, langCode, tableName string) e
 			_, _ = mariaDB.Exec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`", mariaTableName))
 
 			if _, restoreErr := mariaDB.Exec(fmt.Sprintf("RENAME TABLE `%s` TO `%s`", backupTableName, mariaTableName)); restoreErr != nil {
-				return fmt.Errorf("failed to migrate data and restore backup: original error: %v, restore error: %v", err, restoreErr)
+				return table, fmt.Errorf("failed to migrate data and restore backup: original error: %v, restore error: %v", err, restoreErr)
 			}
 		}
-		return fmt.Errorf("failed to migrate data: %v", err)
+		return table, fmt.Errorf("failed to migrate data: %v", err)
+	}
+	table.Migrated = true
+
+	// Record lexemes removed, added or changed since the previous migration for delta syncs.
+	if langCode != "TranslationData" {
+		if err := recordChanges(mariaDB, mariaTableName, backupTableName, exists, tableSchema.ColumnNames, time.Now().UTC()); err != nil {
+			log.Printf("Warning: Failed to record changed rows for %s: %v", mariaTableName, err)
+		}
 	}
 
 	// If everything succeeded, drop the backup table.
@@ -124,7 +136,7 @@ func MigrateTable(sqlite *sql.DB, mariaDB *sql.DB, langCode, tableName string) e
 		}
 	}
 
-	return nil
+	return table, nil
 }
 
 // MARK: Table Check
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mariadb
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// statsHistoryTable keeps a snapshot of the row counts of every language data table per migration run.
+// Scribe-Server reads it to serve the growth of the dataset over time.
+const statsHistoryTable = "language_stats_history"
+
+// MARK: Table Creation
+
+// CreateStatsHistoryTable creates the statistics history table if it does not already exist.
+func CreateStatsHistoryTable(db *sql.DB) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			recorded_at DATETIME NOT NULL,
+			language_iso VARCHAR(3) NOT NULL,
+			data_type VARCHAR(64) NOT NULL,
+			row_count INT NOT NULL,
+			PRIMARY KEY (language_iso, recorded_at, data_type)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`, statsHistoryTable)
+
+	if _, err := db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create %s table: %v", statsHistoryTable, err)
+	}
+
+	return nil
+}
+
+// MARK: Record Snapshot
+
+// RecordStatsSnapshot stores the current row count of every language data table under a single timestamp.
+func RecordStatsSnapshot(db *sql.DB) error {
+	rows, err := db.Query(`
+		SELECT TABLE_NAME
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = DATABASE()
+		AND TABLE_NAME LIKE '%LanguageData%Scribe'
+		ORDER BY TABLE_NAME
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to list language data tables: %v", err)
+	}
+
+	var tableNames []string
+	for rows.Next() {
+		var tableName string
+		if err := rows.Scan(&tableName); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan table name: %v", err)
+		}
+		tableNames = append(tableNames, tableName)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating table names: %v", err)
+	}
+
+	recordedAt := time.Now().UTC().Truncate(time.Second)
+	insert := fmt.Sprintf("INSERT INTO `%s` (recorded_at, language_iso, data_type, row_count) VALUES (?, ?, ?, ?)", statsHistoryTable)
+
+	count := 0
+	for _, tableName := range tableNames {
+		matches := languageTablePattern.FindStringSubmatch(tableName)
+		if matches == nil {
+			continue
+		}
+
+		var rowCount int
+		if err := db.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM `%s`", tableName)).Scan(&rowCount); err != nil {
+			return fmt.Errorf("failed to count rows of %s: %v", tableName, err)
+		}
+
+		if _, err := db.Exec(insert, recordedAt, strings.ToLower(matches[1]), strings.ToLower(matches[2]), rowCount); err != nil {
+			return fmt.Errorf("failed to record stats for %s: %v", tableName, err)
+		}
+		count++
+	}
+
+	log.Printf("Recorded statistics snapshot of %d tables", count)
+	return nil
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mariadb
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/scribe-org/scribe-server/internal/testdb"
+)
+
+// MARK: Record Snapshot
+
+func TestRecordStatsSnapshot(t *testing.T) {
+	db := testdb.Open(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT)",
+		"INSERT INTO DELanguageDataNounsScribe VALUES ('L1'), ('L2'), ('L3')",
+		"CREATE TABLE DAGLanguageDataVerbsScribe (lexemeID TEXT)",
+		"INSERT INTO DAGLanguageDataVerbsScribe VALUES ('L7')",
+		"CREATE TABLE DELanguageDataNounsScribeOld (lexemeID TEXT)",
+		"CREATE TABLE TranslationDataENFromDE (word TEXT)",
+		fmt.Sprintf(`CREATE TABLE %s (
+			recorded_at DATETIME, language_iso TEXT, data_type TEXT, row_count INTEGER,
+			PRIMARY KEY (language_iso, recorded_at, data_type)
+		)`, statsHistoryTable),
+	)
+
+	if err := RecordStatsSnapshot(db); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(fmt.Sprintf("SELECT COUNT(DISTINCT recorded_at), language_iso, data_type, row_count FROM %s GROUP BY language_iso, data_type ORDER BY language_iso", statsHistoryTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var got []string
+	for rows.Next() {
+		var timestamps, rowCount int
+		var lang, dataType string
+		if err := rows.Scan(&timestamps, &lang, &dataType, &rowCount); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, fmt.Sprintf("%s/%s=%d@%d", lang, dataType, rowCount, timestamps))
+	}
+
+	// Backups and translation tables are not language data, and every count shares one timestamp.
+	if want := []string{"dag/verbs=1@1", "de/nouns=3@1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("snapshot = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mariadb
+
+import (
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"slices"
+	"strings"
+	"time"
+)
+
+// tombstonesTable records the lexeme IDs removed from language data tables by a migration.
+// Scribe-Server reads it to serve deletions to clients doing delta syncs.
+const tombstonesTable = "language_data_tombstones"
+
+// upsertsTable records the lexeme IDs whose rows a migration added or changed.
+// Scribe-Server reads it to serve rows to delta syncs whose Wikidata modification time predates the sync,
+// such as lexemes restored after a deletion.
+const upsertsTable = "language_data_upserts"
+
+// lexemeIDColumn is the column identifying a row in language data tables.
+const lexemeIDColumn = "lexemeID"
+
+// MARK: Table Creation
+
+// CreateTombstonesTable creates the tombstones table if it does not already exist.
+func CreateTombstonesTable(db *sql.DB) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			table_name VARCHAR(100) NOT NULL,
+			lexeme_id VARCHAR(32) NOT NULL,
+			deleted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (table_name, lexeme_id),
+			INDEX idx_deleted_at (table_name, deleted_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`, tombstonesTable)
+
+	if _, err := db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create %s table: %v", tombstonesTable, err)
+	}
+
+	return nil
+}
+
+// CreateUpsertsTable creates the upserts table if it does not already exist.
+func CreateUpsertsTable(db *sql.DB) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			table_name VARCHAR(100) NOT NULL,
+			lexeme_id VARCHAR(64) NOT NULL,
+			upserted_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (table_name, lexeme_id),
+			INDEX idx_upserted_at (table_name, upserted_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`, upsertsTable)
+
+	if _, err := db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create %s table: %v", upsertsTable, err)
+	}
+
+	return nil
+}
+
+// MARK: Record Changes
+
+// recordChanges compares the freshly migrated table with its backup, if there is one, and logs the lexemes that changed at migratedAt.
+// Removed lexemes get a tombstone, while lexemes that were added or whose rows differ are recorded as upserted,
+// so that delta syncs serve them even if their modification time predates the sync. A table without a backup counts as added in full.
+func recordChanges(db *sql.DB, tableName, backupTableName string, hasBackup bool, columnNames []string, migratedAt time.Time) error {
+	if !slices.Contains(columnNames, lexemeIDColumn) {
+		return nil
+	}
+
+	newRows, err := selectLexemeFingerprints(db, tableName, columnNames)
+	if err != nil {
+		return err
+	}
+
+	oldRows := map[string]uint64{}
+	sameColumns := true
+	if hasBackup {
+		if oldRows, sameColumns, err = selectBackupFingerprints(db, backupTableName, columnNames); err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %v", err)
+	}
+
+	var committed bool
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Printf("Error rolling back transaction: %v", err)
+			}
+		}
+	}()
+
+	changes := changeLog{tx: tx, tableName: tableName, migratedAt: migratedAt}
+
+	removed, upserted := 0, 0
+	for id := range oldRows {
+		if _, ok := newRows[id]; ok {
+			continue
+		}
+		if err := changes.record(tombstonesTable, upsertsTable, id); err != nil {
+			return err
+		}
+		removed++
+	}
+
+	for id, fingerprint := range newRows {
+		if oldFingerprint, ok := oldRows[id]; ok && sameColumns && oldFingerprint == fingerprint {
+			continue
+		}
+		if err := changes.record(upsertsTable, tombstonesTable, id); err != nil {
+			return err
+		}
+		upserted++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	committed = true
+
+	log.Printf("Recorded %d removed and %d added or changed lexemes for table %s", removed, upserted, tableName)
+	return nil
+}
+
+// changeLog writes the tombstones and upserts of a table within a transaction.
+type changeLog struct {
+	tx         *sql.Tx
+	tableName  string
+	migratedAt time.Time
+}
+
+// record logs a lexeme in one table at the migration time and clears it from the other,
+// as a lexeme is either removed or present. Existing entries are replaced, which keeps the statements portable.
+func (l changeLog) record(table, otherTable, lexemeID string) error {
+	for _, target := range []string{table, otherTable} {
+		if _, err := l.tx.Exec(fmt.Sprintf("DELETE FROM `%s` WHERE table_name = ? AND lexeme_id = ?", target), l.tableName, lexemeID); err != nil {
+			return fmt.Errorf("failed to clear %s entry for %s: %v", target, lexemeID, err)
+		}
+	}
+
+	timeColumn := "upserted_at"
+	if table == tombstonesTable {
+		timeColumn = "deleted_at"
+	}
+	query := fmt.Sprintf("INSERT INTO `%s` (table_name, lexeme_id, %s) VALUES (?, ?, ?)", table, timeColumn)
+	if _, err := l.tx.Exec(query, l.tableName, lexemeID, l.migratedAt); err != nil {
+		return fmt.Errorf("failed to record %s entry for %s: %v", table, lexemeID, err)
+	}
+
+	return nil
+}
+
+// selectBackupFingerprints reads the lexemes of a backup table and whether their fingerprints are comparable with those of the new table.
+// If the backup has other columns than the new table, only the lexeme IDs are read, as every lexeme counts as changed.
+func selectBackupFingerprints(db *sql.DB, backupTableName string, columnNames []string) (map[string]uint64, bool, error) {
+	rows, err := db.Query(fmt.Sprintf("SELECT * FROM `%s` LIMIT 0", backupTableName))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read columns of %s: %v", backupTableName, err)
+	}
+	backupColumns, err := rows.Columns()
+	rows.Close()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read columns of %s: %v", backupTableName, err)
+	}
+
+	sameColumns := slices.Equal(slices.Sorted(slices.Values(backupColumns)), slices.Sorted(slices.Values(columnNames)))
+	if !sameColumns {
+		columnNames = []string{lexemeIDColumn}
+	}
+
+	fingerprints, err := selectLexemeFingerprints(db, backupTableName, columnNames)
+	return fingerprints, sameColumns, err
+}
+
+// selectLexemeFingerprints reads the lexeme IDs present in a table along with a fingerprint of their rows' values.
+// A lexeme's fingerprint sums the hashes of its rows, so it does not depend on the order rows are read in.
+func selectLexemeFingerprints(db *sql.DB, tableName string, columnNames []string) (map[string]uint64, error) {
+	columns := slices.DeleteFunc(slices.Clone(columnNames), func(column string) bool { return column == lexemeIDColumn })
+	selection := "`" + strings.Join(append([]string{lexemeIDColumn}, columns...), "`, `") + "`"
+
+	rows, err := db.Query(fmt.Sprintf("SELECT %s FROM `%s`", selection, tableName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to select lexemes from %s: %v", tableName, err)
+	}
+	defer rows.Close()
+
+	values := make([]sql.NullString, len(columns)+1)
+	scanArgs := make([]any, len(values))
+	for i := range values {
+		scanArgs[i] = &values[i]
+	}
+
+	fingerprints := make(map[string]uint64)
+	for rows.Next() {
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, fmt.Errorf("failed to scan lexeme row: %v", err)
+		}
+
+		id := values[0]
+		if !id.Valid || strings.TrimSpace(id.String) == "" {
+			continue
+		}
+
+		hash := fnv.New64a()
+		for _, value := range values[1:] {
+			// A separator and a NULL marker keep different rows from hashing alike.
+			if value.Valid {
+				hash.Write([]byte{1})
+				hash.Write([]byte(value.String))
+			} else {
+				hash.Write([]byte{0})
+			}
+			hash.Write([]byte{0x1f})
+		}
+		fingerprints[id.String] += hash.Sum64()
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %v", err)
+	}
+
+	return fingerprints, nil
+}
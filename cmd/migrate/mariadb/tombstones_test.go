@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mariadb
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	_ "github.com/glebarez/sqlite"
+)
+
+// openChangesDB returns an in-memory SQLite database holding the change log tables and the given statements' tables.
+func openChangesDB(t *testing.T, statements ...string) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("opening test database: %v", err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	statements = append([]string{
+		fmt.Sprintf("CREATE TABLE %s (table_name TEXT, lexeme_id TEXT, deleted_at TIMESTAMP, PRIMARY KEY (table_name, lexeme_id))", tombstonesTable),
+		fmt.Sprintf("CREATE TABLE %s (table_name TEXT, lexeme_id TEXT, upserted_at TIMESTAMP, PRIMARY KEY (table_name, lexeme_id))", upsertsTable),
+	}, statements...)
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("preparing test database: %v", err)
+		}
+	}
+
+	return db
+}
+
+// loggedLexemes returns the lexeme IDs a change log table holds for a table, sorted.
+func loggedLexemes(t *testing.T, db *sql.DB, logTable, tableName string) []string {
+	t.Helper()
+
+	rows, err := db.Query(fmt.Sprintf("SELECT lexeme_id FROM %s WHERE table_name = ? ORDER BY lexeme_id", logTable), tableName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// MARK: Record Changes
+
+func TestRecordChanges(t *testing.T) {
+	const table = "DELanguageDataNounsScribe"
+	db := openChangesDB(t,
+		"CREATE TABLE DELanguageDataNounsScribeOld (lexemeID TEXT, singular TEXT, plural TEXT)",
+		`INSERT INTO DELanguageDataNounsScribeOld VALUES
+			('L1', 'Haus', 'Häuser'), ('L2', 'Baum', NULL), ('L4', 'Tür', 'Türen'), ('L5', 'Hand', 'Hände'), ('L5', 'Hand', 'Händen')`,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, singular TEXT, plural TEXT)",
+		`INSERT INTO DELanguageDataNounsScribe VALUES
+			('L1', 'Haus', 'Häuser'), ('L2', 'Baum', 'Bäume'), ('L3', 'Tisch', 'Tische'), ('L5', 'Hand', 'Händen'), ('L5', 'Hand', 'Hände')`,
+		// L3 was removed by an earlier migration and is restored now, L4 was added by one and is removed now.
+		fmt.Sprintf("INSERT INTO %s VALUES ('%s', 'L3', '2024-01-01 00:00:00')", tombstonesTable, table),
+		fmt.Sprintf("INSERT INTO %s VALUES ('%s', 'L4', '2024-01-01 00:00:00')", upsertsTable, table),
+	)
+
+	migratedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := recordChanges(db, table, table+"Old", true, []string{"lexemeID", "singular", "plural"}, migratedAt); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := loggedLexemes(t, db, tombstonesTable, table), []string{"L4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tombstones = %v, want %v", got, want)
+	}
+	// L2 changed and L3 was restored; L1 is unchanged and L5 only has its rows in another order.
+	if got, want := loggedLexemes(t, db, upsertsTable, table), []string{"L2", "L3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("upserts = %v, want %v", got, want)
+	}
+
+	var upsertedAt time.Time
+	if err := db.QueryRow(fmt.Sprintf("SELECT upserted_at FROM %s WHERE lexeme_id = 'L3'", upsertsTable)).Scan(&upsertedAt); err != nil {
+		t.Fatal(err)
+	}
+	if !upsertedAt.Equal(migratedAt) {
+		t.Errorf("upserted_at = %v, want the migration time %v", upsertedAt, migratedAt)
+	}
+}
+
+func TestRecordChangesWithChangedColumns(t *testing.T) {
+	const table = "DELanguageDataNounsScribe"
+	db := openChangesDB(t,
+		"CREATE TABLE DELanguageDataNounsScribeOld (lexemeID TEXT, singular TEXT)",
+		"INSERT INTO DELanguageDataNounsScribeOld VALUES ('L1', 'Haus'), ('L2', 'Baum')",
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, singular TEXT, plural TEXT)",
+		"INSERT INTO DELanguageDataNounsScribe VALUES ('L1', 'Haus', 'Häuser')",
+	)
+
+	if err := recordChanges(db, table, table+"Old", true, []string{"lexemeID", "singular", "plural"}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := loggedLexemes(t, db, tombstonesTable, table), []string{"L2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tombstones = %v, want %v", got, want)
+	}
+	if got, want := loggedLexemes(t, db, upsertsTable, table), []string{"L1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("upserts = %v, want %v as the columns changed", got, want)
+	}
+}
+
+func TestRecordChangesWithoutBackup(t *testing.T) {
+	const table = "DELanguageDataVerbsScribe"
+	db := openChangesDB(t,
+		"CREATE TABLE DELanguageDataVerbsScribe (lexemeID TEXT, infinitive TEXT)",
+		"INSERT INTO DELanguageDataVerbsScribe VALUES ('L7', 'gehen'), ('L8', 'sehen'), (NULL, 'sein'), ('  ', 'haben')",
+	)
+
+	if err := recordChanges(db, table, table+"Old", false, []string{"lexemeID", "infinitive"}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := loggedLexemes(t, db, upsertsTable, table), []string{"L7", "L8"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("upserts = %v, want %v", got, want)
+	}
+	if got := loggedLexemes(t, db, tombstonesTable, table); len(got) != 0 {
+		t.Errorf("tombstones = %v, want none", got)
+	}
+}
+
+func TestRecordChangesIgnoresTablesWithoutLexemeIDs(t *testing.T) {
+	db := openChangesDB(t, "CREATE TABLE DELanguageDataEmojiKeywordsScribe (word TEXT, emoji TEXT)")
+
+	if err := recordChanges(db, "DELanguageDataEmojiKeywordsScribe", "", false, []string{"word", "emoji"}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if got := loggedLexemes(t, db, upsertsTable, "DELanguageDataEmojiKeywordsScribe"); len(got) != 0 {
+		t.Errorf("upserts = %v, want none", got)
+	}
+}
@@ -6,6 +6,7 @@ package schema
 import (
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/scribe-org/scribe-server/cmd/migrate/types"
@@ -66,13 +67,41 @@ func GetTableSchema(db *sql.DB, tableName string) (*types.TableSchema, error) {
 	return schema, nil
 }
 
+// rowIDColumn is the invisible auto-increment column added to every table, so that rows sharing a key can be told apart when paging.
+const rowIDColumn = "rowID"
+
+// keyPrefixLength is the number of characters of a TEXT key column that are indexed.
+const keyPrefixLength = 191
+
+// keyColumn returns the column the server orders and pages a table by: the lexeme ID if present, otherwise the first column.
+func keyColumn(schema *types.TableSchema) int {
+	if i := slices.Index(schema.ColumnNames, "lexemeID"); i >= 0 {
+		return i
+	}
+	return 0
+}
+
 // GenerateCreateTableSQL generates CREATE TABLE SQL for MariaDB.
+// Besides the columns of the schema, the table gets an invisible row ID as its primary key and an index on its key column and row ID.
 func GenerateCreateTableSQL(tableName string, schema *types.TableSchema) string {
-	columns := make([]string, len(schema.ColumnNames))
+	columns := make([]string, len(schema.ColumnNames), len(schema.ColumnNames)+3)
 	for i := range schema.ColumnNames {
 		columns[i] = fmt.Sprintf("`%s` %s", schema.ColumnNames[i], schema.ColumnTypes[i])
 	}
 
+	columns = append(columns, fmt.Sprintf("`%s` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT INVISIBLE", rowIDColumn))
+	columns = append(columns, fmt.Sprintf("PRIMARY KEY (`%s`)", rowIDColumn))
+
+	if len(schema.ColumnNames) > 0 {
+		key := keyColumn(schema)
+		indexed := fmt.Sprintf("`%s`", schema.ColumnNames[key])
+		// TEXT and BLOB columns can only be indexed by a prefix.
+		if columnType := strings.ToUpper(schema.ColumnTypes[key]); strings.Contains(columnType, "TEXT") || strings.Contains(columnType, "BLOB") {
+			indexed += fmt.Sprintf("(%d)", keyPrefixLength)
+		}
+		columns = append(columns, fmt.Sprintf("KEY `keyOrder` (%s, `%s`)", indexed, rowIDColumn))
+	}
+
 	return fmt.Sprintf(
 		"CREATE TABLE IF NOT EXISTS `%s` (\n    %s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;",
 		tableName, strings.Join(columns, ",\n    "),
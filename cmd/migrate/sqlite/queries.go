@@ -13,20 +13,25 @@ import (
 
 	"github.com/scribe-org/scribe-server/cmd/migrate/mariadb"
 	"github.com/scribe-org/scribe-server/cmd/migrate/schema"
+	"github.com/scribe-org/scribe-server/cmd/migrate/types"
 )
 
 // ProcessSQLiteFiles processes all SQLite files in the specified directory.
-func ProcessSQLiteFiles(mariaDB *sql.DB) error {
+// It returns every table found in the files, whether or not its migration succeeded.
+func ProcessSQLiteFiles(mariaDB *sql.DB) ([]types.MigratedTable, error) {
 	sqliteDir := "./packs/sqlite"
 	files, err := filepath.Glob(filepath.Join(sqliteDir, "*.sqlite"))
 	if err != nil {
-		return fmt.Errorf("failed to read directory: %v", err)
+		return nil, fmt.Errorf("failed to read directory: %v", err)
 	}
 
 	semaphore := make(chan struct{}, 4)
 	errChan := make(chan error, len(files))
 	var wg sync.WaitGroup
 
+	var mu sync.Mutex
+	var migrated []types.MigratedTable
+
 	for _, file := range files {
 		wg.Add(1)
 		go func(filepath string) {
@@ -34,9 +39,14 @@ func ProcessSQLiteFiles(mariaDB *sql.DB) error {
 			semaphore <- struct{}{}
 			defer func() { <-semaphore }()
 
-			if err := processSQLiteFile(filepath, mariaDB); err != nil {
+			tables, err := processSQLiteFile(filepath, mariaDB)
+			if err != nil {
 				errChan <- fmt.Errorf("error processing %s: %v", filepath, err)
 			}
+
+			mu.Lock()
+			migrated = append(migrated, tables...)
+			mu.Unlock()
 		}(file)
 	}
 
@@ -47,11 +57,11 @@ func ProcessSQLiteFiles(mariaDB *sql.DB) error {
 		log.Printf("%v", err)
 	}
 
-	return nil
+	return migrated, nil
 }
 
-// processSQLiteFile handles processing of a single SQLite file.
-func processSQLiteFile(filePath string, mariaDB *sql.DB) error {
+// processSQLiteFile handles processing of a single SQLite file and returns the tables it holds.
+func processSQLiteFile(filePath string, mariaDB *sql.DB) ([]types.MigratedTable, error) {
 	log.Printf("Processing file: %s", filePath)
 
 	// Extract language code.
@@ -61,22 +71,30 @@ func processSQLiteFile(filePath string, mariaDB *sql.DB) error {
 		langCode = strings.Split(base, "_")[0]
 	}
 
+	// Table names carry the language code in upper case, whether it has two letters (DE) or three (DAG).
+	if code, ok := strings.CutSuffix(langCode, "LanguageData"); ok {
+		langCode = strings.ToUpper(code) + "LanguageData"
+	}
+
 	sqlite, err := sql.Open("sqlite", filePath)
 	if err != nil {
-		return fmt.Errorf("failed to open SQLite file: %v", err)
+		return nil, fmt.Errorf("failed to open SQLite file: %v", err)
 	}
 	defer sqlite.Close()
 
 	tables, err := schema.GetTables(sqlite)
 	if err != nil {
-		return fmt.Errorf("failed to get tables: %v", err)
+		return nil, fmt.Errorf("failed to get tables: %v", err)
 	}
 
+	migrated := make([]types.MigratedTable, 0, len(tables))
 	for _, table := range tables {
-		if err := mariadb.MigrateTable(sqlite, mariaDB, langCode, table); err != nil {
+		result, err := mariadb.MigrateTable(sqlite, mariaDB, langCode, table)
+		if err != nil {
 			log.Printf("Error migrating table %s: %v", table, err)
 		}
+		migrated = append(migrated, result)
 	}
 
-	return nil
+	return migrated, nil
 }
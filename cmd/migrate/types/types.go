@@ -12,6 +12,16 @@ type TableSchema struct {
 	ColumnTypes []string
 }
 
+// MigratedTable describes a table of the SQLite files processed by a migration run.
+type MigratedTable struct {
+	// Name of the table in MariaDB
+	Name string
+	// Schema of the SQLite table, nil if it could not be read
+	Schema *TableSchema
+	// Whether the data was migrated, rather than the previous table kept after an error
+	Migrated bool
+}
+
 // DBConnections holds database connections.
 type DBConnections struct {
 	MariaDB *sql.DB
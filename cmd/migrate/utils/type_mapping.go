@@ -29,6 +29,10 @@ func MapColumnTypeToMariaDB(columnName, sqliteType string) string {
 	if strings.EqualFold(columnName, "Lastmodified") {
 		return "TIMESTAMP"
 	}
+	// Lexeme IDs key the rows of language data tables, so they get a type that can be indexed in full.
+	if strings.EqualFold(columnName, "lexemeID") {
+		return "VARCHAR(64)"
+	}
 	// Default type mapping.
 	return MapSQLiteTypeToMariaDB(sqliteType)
 }
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/contracts"
+	"github.com/scribe-org/scribe-server/models"
+	"github.com/spf13/viper"
+)
+
+// main compares the exported contracts with the migrated tables and exits with status 1 if they drifted apart.
+func main() {
+	lang := flag.String("lang", "", "only validate the contract of this language code")
+	dir := flag.String("dir", "", "contracts directory (defaults to contractsDir of the config)")
+	flag.Parse()
+
+	// Read configuration from file or environment variables, as the server does.
+	viper.SetConfigName("config")
+	viper.SetConfigType("yaml")
+	viper.AddConfigPath(".")
+	if err := viper.ReadInConfig(); err != nil {
+		if _, configFileNotFound := err.(viper.ConfigFileNotFoundError); !configFileNotFound {
+			log.Fatalf("Failed to read config file: %v", err)
+		}
+		viper.AutomaticEnv()
+	}
+	viper.SetDefault("contractsDir", "./contracts")
+	if *dir == "" {
+		*dir = viper.GetString("contractsDir")
+	}
+
+	if err := database.InitDatabase(); err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer database.DB.Close()
+
+	selected, err := loadContracts(*dir, *lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	drifted := 0
+	for _, code := range slices.Sorted(maps.Keys(selected)) {
+		report, err := contracts.Validate(selected[code])
+		if err != nil {
+			log.Fatalf("Failed to validate contract for %s: %v", code, err)
+		}
+		printReport(report)
+		if !report.Valid {
+			drifted++
+		}
+	}
+
+	if drifted > 0 {
+		fmt.Printf("\n%d of %d contracts do not match the database\n", drifted, len(selected))
+		database.DB.Close()
+		os.Exit(1)
+	}
+	fmt.Printf("\nAll %d contracts match the database\n", len(selected))
+}
+
+// loadContracts loads the contract of a single language, or those of all languages with data in the database.
+func loadContracts(dir, lang string) (map[string]*contracts.Contract, error) {
+	if lang != "" {
+		lang = strings.ToLower(lang)
+		contract, err := contracts.LoadFile(contracts.FilePath(dir, lang), lang)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]*contracts.Contract{lang: contract}, nil
+	}
+
+	all, err := contracts.LoadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	available, err := database.GetAvailableLanguages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch available languages: %w", err)
+	}
+
+	// Contracts are exported for every language Scribe-Data knows, but only migrated languages can be compared.
+	maps.DeleteFunc(all, func(code string, _ *contracts.Contract) bool {
+		return !slices.Contains(available, code)
+	})
+	return all, nil
+}
+
+// printReport writes the drift of each data type of a language, or a single line if its contract matches.
+func printReport(report models.ContractValidationResponse) {
+	if report.Valid {
+		fmt.Printf("✅ %s: contract matches the database\n", report.Language)
+		return
+	}
+
+	for _, dataType := range slices.Sorted(maps.Keys(report.DataTypes)) {
+		drift := report.DataTypes[dataType]
+		prefix := fmt.Sprintf("❌ %s %s:", report.Language, dataType)
+		if drift.InContract && !drift.HasTable {
+			fmt.Printf("%s no table for data type in contract\n", prefix)
+			continue
+		}
+		if len(drift.MissingColumns) > 0 {
+			fmt.Printf("%s missing columns: %s\n", prefix, strings.Join(drift.MissingColumns, ", "))
+		}
+		if len(drift.UnexpectedColumns) > 0 {
+			fmt.Printf("%s unexpected columns: %s\n", prefix, strings.Join(drift.UnexpectedColumns, ", "))
+		}
+		for _, mismatch := range drift.TypeMismatches {
+			fmt.Printf("%s column %s is %s, expected %s\n", prefix, mismatch.Column, mismatch.Actual, mismatch.Expected)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// CompletionsTable is the prefix index built by the migration from noun and verb forms.
+const CompletionsTable = "language_completions"
+
+// MARK: Get Completions
+
+// GetCompletions returns the indexed terms of a language starting with prefix, ignoring case.
+// The prefix is turned into a range over the binary collated term key, so lookups use the index.
+// Databases that were migrated before the index was built yield no results.
+func GetCompletions(lang, prefix string, limit int) ([]models.Completion, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d", limit)
+	}
+
+	exists, err := TableExists(CompletionsTable)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []models.Completion{}, nil
+	}
+
+	lowerBound := strings.ToLower(prefix)
+	upperBound := lowerBound + string(rune(0x10FFFF))
+
+	query := fmt.Sprintf(`
+		SELECT term, data_type, lexeme_id
+		FROM %s
+		WHERE language_iso = ? AND term_key >= ? AND term_key < ?
+		ORDER BY term_key, data_type, lexeme_id
+		LIMIT ?
+	`, CompletionsTable)
+
+	rows, err := DB.Query(query, strings.ToLower(lang), lowerBound, upperBound, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error querying completions: %w", err)
+	}
+	defer rows.Close()
+
+	completions := []models.Completion{}
+	for rows.Next() {
+		var completion models.Completion
+		if err := rows.Scan(&completion.Term, &completion.DataType, &completion.LexemeID); err != nil {
+			return nil, fmt.Errorf("error scanning completion: %w", err)
+		}
+		completions = append(completions, completion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating completions: %w", err)
+	}
+
+	return completions, nil
+}
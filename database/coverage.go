@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// MARK: Column Coverage
+
+// GetColumnCoverage counts the rows of a table and, for each given column, the rows in which it holds a value.
+// NULLs never count as values, and neither do empty strings in text columns.
+func GetColumnCoverage(tableName string, columns []string, schema map[string]string) (int, map[string]int, error) {
+	if !IsValidTableName(tableName) {
+		return 0, nil, fmt.Errorf("invalid table name")
+	}
+
+	selections := make([]string, 0, len(columns)+1)
+	selections = append(selections, "COUNT(*)")
+	for _, column := range columns {
+		if !IsValidColumnName(column) {
+			return 0, nil, fmt.Errorf("invalid column name: %s", column)
+		}
+
+		condition := fmt.Sprintf("`%s` IS NOT NULL", column)
+		if IsTextColumnType(schema[column]) {
+			condition += fmt.Sprintf(" AND `%s` <> ''", column)
+		}
+		selections = append(selections, fmt.Sprintf("COALESCE(SUM(CASE WHEN %s THEN 1 ELSE 0 END), 0)", condition))
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM `%s`", strings.Join(selections, ", "), tableName)
+
+	counts := make([]sql.NullInt64, len(selections))
+	scanArgs := make([]any, len(counts))
+	for i := range counts {
+		scanArgs[i] = &counts[i]
+	}
+
+	if err := DB.QueryRow(query).Scan(scanArgs...); err != nil {
+		return 0, nil, fmt.Errorf("error counting column values of %s: %w", tableName, err)
+	}
+
+	filled := make(map[string]int, len(columns))
+	for i, column := range columns {
+		filled[column] = int(counts[i+1].Int64)
+	}
+
+	return int(counts[0].Int64), filled, nil
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FilterOperator is a comparison a Filter applies to a column.
+type FilterOperator string
+
+// Operators supported by row filters.
+const (
+	// FilterEq matches rows whose column equals the single value.
+	FilterEq FilterOperator = "eq"
+	// FilterPrefix matches rows whose column starts with the single value.
+	FilterPrefix FilterOperator = "prefix"
+	// FilterIn matches rows whose column equals any of the values.
+	FilterIn FilterOperator = "in"
+	// FilterIsNull matches rows whose column is NULL, or is not NULL if the single value is "false".
+	FilterIsNull FilterOperator = "is_null"
+)
+
+// likeEscaper escapes the wildcards of a LIKE pattern so values match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// MARK: Row Filters
+
+// Filter restricts the rows of a TableQuery by comparing a column with one or more values.
+type Filter struct {
+	// Column the filter applies to
+	Column string
+	// Comparison to apply
+	Operator FilterOperator
+	// Values to compare with, exactly one for all operators except FilterIn
+	Values []string
+}
+
+// compile renders the filter as a parameterized SQL condition.
+// Only the validated column name is placed in the SQL; values are always passed as arguments.
+func (f Filter) compile() (string, []any, error) {
+	if !IsValidColumnName(f.Column) {
+		return "", nil, fmt.Errorf("invalid filter column: %s", f.Column)
+	}
+	if len(f.Values) == 0 || (f.Operator != FilterIn && len(f.Values) != 1) {
+		return "", nil, fmt.Errorf("invalid number of values for %s filter on %s", f.Operator, f.Column)
+	}
+
+	switch f.Operator {
+	case FilterEq:
+		return fmt.Sprintf("`%s` = ?", f.Column), []any{f.Values[0]}, nil
+
+	case FilterPrefix:
+		return fmt.Sprintf("`%s` LIKE ?", f.Column), []any{likeEscaper.Replace(f.Values[0]) + "%"}, nil
+
+	case FilterIn:
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(f.Values)), ", ")
+		args := make([]any, len(f.Values))
+		for i, value := range f.Values {
+			args[i] = value
+		}
+		return fmt.Sprintf("`%s` IN (%s)", f.Column, placeholders), args, nil
+
+	case FilterIsNull:
+		if f.Values[0] == "false" {
+			return fmt.Sprintf("`%s` IS NOT NULL", f.Column), nil, nil
+		}
+		return fmt.Sprintf("`%s` IS NULL", f.Column), nil, nil
+	}
+
+	return "", nil, fmt.Errorf("unknown filter operator: %s", f.Operator)
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// MARK: Compilation
+
+func TestFilterCompile(t *testing.T) {
+	tests := []struct {
+		filter    Filter
+		condition string
+		args      []any
+	}{
+		{Filter{"gender", FilterEq, []string{"feminine"}}, "`gender` = ?", []any{"feminine"}},
+		{Filter{"singular", FilterPrefix, []string{"Ha"}}, "`singular` LIKE ?", []any{"Ha%"}},
+		{Filter{"singular", FilterPrefix, []string{`50%_off\`}}, "`singular` LIKE ?", []any{`50\%\_off\\%`}},
+		{Filter{"gender", FilterIn, []string{"feminine"}}, "`gender` IN (?)", []any{"feminine"}},
+		{Filter{"gender", FilterIn, []string{"feminine", "neuter", ""}}, "`gender` IN (?, ?, ?)", []any{"feminine", "neuter", ""}},
+		{Filter{"plural", FilterIsNull, []string{"true"}}, "`plural` IS NULL", nil},
+		{Filter{"plural", FilterIsNull, []string{"false"}}, "`plural` IS NOT NULL", nil},
+	}
+
+	for _, tt := range tests {
+		condition, args, err := tt.filter.compile()
+		if err != nil {
+			t.Errorf("compile(%+v): %v", tt.filter, err)
+			continue
+		}
+		if condition != tt.condition || fmt.Sprint(args) != fmt.Sprint(tt.args) || len(args) != len(tt.args) {
+			t.Errorf("compile(%+v) = %q, %q, want %q, %q", tt.filter, condition, args, tt.condition, tt.args)
+		}
+	}
+}
+
+func TestFilterCompileRejectsInvalidFilters(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+	}{
+		{"empty column", Filter{"", FilterEq, []string{"x"}}},
+		{"quoted column", Filter{"gender`", FilterEq, []string{"x"}}},
+		{"column with spaces", Filter{"gender OR 1=1", FilterEq, []string{"x"}}},
+		{"column with comment", Filter{"gender--", FilterEq, []string{"x"}}},
+		{"overlong column", Filter{strings.Repeat("a", 65), FilterEq, []string{"x"}}},
+		{"unknown operator", Filter{"gender", FilterOperator("like"), []string{"x"}}},
+		{"no values", Filter{"gender", FilterIn, nil}},
+		{"several values for eq", Filter{"gender", FilterEq, []string{"a", "b"}}},
+		{"several values for prefix", Filter{"gender", FilterPrefix, []string{"a", "b"}}},
+		{"no value for is_null", Filter{"gender", FilterIsNull, []string{}}},
+	}
+
+	for _, tt := range tests {
+		if condition, args, err := tt.filter.compile(); err == nil {
+			t.Errorf("%s: compile = %q, %v, want error", tt.name, condition, args)
+		}
+	}
+}
+
+func TestFilterCompileBindsValuesAsPlaceholders(t *testing.T) {
+	values := []string{"' OR '1'='1", "`; DROP TABLE DELanguageDataNounsScribe; --", `\' UNION SELECT 1 --`, "?"}
+
+	for _, operator := range []FilterOperator{FilterEq, FilterPrefix, FilterIn} {
+		for _, value := range values {
+			condition, args, err := Filter{"singular", operator, []string{value}}.compile()
+			if err != nil {
+				t.Fatalf("%s %q: %v", operator, value, err)
+			}
+			if strings.Count(condition, "?") != len(args) {
+				t.Errorf("%s %q: condition %q has %d placeholders for %d args", operator, value, condition, strings.Count(condition, "?"), len(args))
+			}
+			for _, part := range []string{"'", "DROP", "UNION", ";"} {
+				if strings.Contains(condition, part) {
+					t.Errorf("%s %q: value leaked into condition %q", operator, value, condition)
+				}
+			}
+		}
+	}
+}
+
+// MARK: Filtered Queries
+
+func TestOpenTableRowsAppliesFilters(t *testing.T) {
+	openTestDB(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, singular TEXT, gender TEXT, plural TEXT)",
+		`INSERT INTO DELanguageDataNounsScribe VALUES
+			('L1', 'Haus', 'neuter', 'Häuser'), ('L2', 'Hand', 'feminine', 'Hände'),
+			('L3', 'Baum', 'masculine', NULL), ('L4', 'Tür', 'feminine', 'Türen')`,
+	)
+
+	tests := []struct {
+		filters []Filter
+		want    string
+	}{
+		{[]Filter{{"gender", FilterEq, []string{"feminine"}}}, "L2,L4"},
+		{[]Filter{{"gender", FilterIn, []string{"neuter", "masculine"}}}, "L1,L3"},
+		{[]Filter{{"plural", FilterIsNull, []string{"true"}}}, "L3"},
+		{[]Filter{{"gender", FilterEq, []string{"feminine"}}, {"plural", FilterIsNull, []string{"false"}}}, "L2,L4"},
+		{[]Filter{{"singular", FilterPrefix, []string{"Ha"}}}, "L1,L2"},
+		{[]Filter{{"gender", FilterEq, []string{"' OR '1'='1"}}}, ""},
+		{[]Filter{{"gender", FilterIn, []string{"x') OR ('1'='1"}}}, ""},
+	}
+
+	for _, tt := range tests {
+		rows, err := OpenTableRows(TableQuery{
+			TableName: "DELanguageDataNounsScribe",
+			KeyColumn: LexemeIDColumn,
+			Filters:   tt.filters,
+		})
+		if err != nil {
+			t.Fatalf("filters %+v: %v", tt.filters, err)
+		}
+
+		var ids []string
+		for rows.Next() {
+			ids = append(ids, rows.Row()[LexemeIDColumn].(string))
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		rows.Close()
+
+		if got := strings.Join(ids, ","); got != tt.want {
+			t.Errorf("filters %+v matched %q, want %q", tt.filters, got, tt.want)
+		}
+	}
+}
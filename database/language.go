@@ -11,6 +11,7 @@ import (
 	"github.com/go-sql-driver/mysql"
 	"github.com/scribe-org/scribe-server/models"
 	"github.com/spf13/viper"
+	"golang.org/x/text/language"
 )
 
 // MARK: Get Available Languages
@@ -18,7 +19,7 @@ import (
 // GetAvailableLanguages retrieves all available languages in the database.
 func GetAvailableLanguages() ([]string, error) {
 	query := `
-		SELECT DISTINCT SUBSTRING(TABLE_NAME, 1, 2) as language_code
+		SELECT DISTINCT SUBSTRING_INDEX(TABLE_NAME, 'LanguageData', 1) as language_code
 		FROM information_schema.TABLES
 		WHERE TABLE_SCHEMA = ?
 		AND TABLE_NAME LIKE '%LanguageData%Scribe'
@@ -37,6 +38,9 @@ func GetAvailableLanguages() ([]string, error) {
 		if err := rows.Scan(&lang); err != nil {
 			return nil, fmt.Errorf("error scanning language: %w", err)
 		}
+		if !IsValidLanguageTableCode(lang) {
+			continue
+		}
 		languages = append(languages, strings.ToLower(lang))
 	}
 
@@ -47,7 +51,7 @@ func GetAvailableLanguages() ([]string, error) {
 
 // GetLanguageDataTypes retrieves all available data types in a sample language table.
 func GetLanguageDataTypes(lang string) ([]string, error) {
-	if len(lang) != 2 {
+	if !IsValidLanguageTableCode(lang) {
 		return nil, fmt.Errorf("invalid language code")
 	}
 
@@ -69,7 +73,7 @@ func GetLanguageDataTypes(lang string) ([]string, error) {
 
 	var dataTypes []string
 	// Regex to extract data type from table name like ENLanguageDataNounsScribe.
-	re := regexp.MustCompile(`^[A-Z]{2}LanguageData([A-Za-z]+)Scribe$`)
+	re := regexp.MustCompile(`^[A-Z]{2,3}LanguageData([A-Za-z]+)Scribe$`)
 
 	for rows.Next() {
 		var tableName string
@@ -89,54 +93,109 @@ func GetLanguageDataTypes(lang string) ([]string, error) {
 
 // MARK: Language Statistics
 
-// GetLanguageStat retrieves noun and verb statistics for a specific language.
+// GetLanguageStat retrieves the number of entries of every data type of a specific language,
+// along with the number of translation entries from it into each target language.
+// The result holds "code", "data_types" (map[string]int) and "translations" (map[string]int).
 func GetLanguageStat(lan string) (map[string]any, error) {
-	// Normalize and validate language code (e.g., "EN", "FR").
+	// Normalize and validate language code (e.g., "EN", "FR", "DAG").
 	lang := strings.ToUpper(strings.TrimSpace(lan))
-	if !regexp.MustCompile(`^[A-Z]{2}$`).MatchString(lang) {
+	if !IsValidLanguageTableCode(lang) {
 		return nil, fmt.Errorf("invalid language code: %s", lang)
 	}
 
-	nounsTable := lang + "LanguageDataNounsScribe"
-	verbsTable := lang + "LanguageDataVerbsScribe"
-
-	if !IsValidTableName(nounsTable) || !IsValidTableName(verbsTable) {
-		return nil, fmt.Errorf("invalid table names for language: %s", lang)
+	dataTypes, err := GetLanguageDataTypes(lang)
+	if err != nil {
+		return nil, err
+	}
+	if len(dataTypes) == 0 {
+		log.Printf("⚠️ Skipping %s — no language data tables", lang)
+		return nil, nil
 	}
 
-	query := fmt.Sprintf(`
-        SELECT
-            (SELECT COUNT(*) FROM %s) AS nouns,
-            (SELECT COUNT(*) FROM %s) AS verbs
-    `, nounsTable, verbsTable)
-
-	// Execute the query.
-	row := DB.QueryRow(query)
+	// Count all data types in a single round trip.
+	counts := make([]string, 0, len(dataTypes))
+	args := make([]any, 0, len(dataTypes))
+	for _, dataType := range dataTypes {
+		tableName := LanguageTableName(lang, dataType)
+		if !IsValidTableName(tableName) {
+			return nil, fmt.Errorf("invalid table name for language %s: %s", lang, tableName)
+		}
+		counts = append(counts, fmt.Sprintf("SELECT ?, COUNT(*) FROM `%s`", tableName))
+		args = append(args, dataType)
+	}
 
-	var nouns, verbs int
-	err := row.Scan(&nouns, &verbs)
+	dataTypeCounts, err := queryCounts(strings.Join(counts, " UNION ALL "), args...)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1146 {
 			log.Printf("⚠️ Skipping %s — missing table: %s", lang, mysqlErr.Message)
 			return nil, nil
 		}
-		if strings.Contains(err.Error(), "doesn't exist") ||
-			strings.Contains(err.Error(), "no such table") {
-			log.Printf("⚠️ Skipping %s — missing table(s): %v", lang, err)
-			return nil, nil
-		}
 		return nil, fmt.Errorf("error scanning stats for %s: %w", lang, err)
 	}
 
+	translationCounts, err := getTranslationCounts(lang)
+	if err != nil {
+		return nil, fmt.Errorf("error counting translations for %s: %w", lang, err)
+	}
+
 	return map[string]any{
-		"code":  strings.ToLower(lang),
-		"nouns": nouns,
-		"verbs": verbs,
+		"code":         strings.ToLower(lang),
+		"data_types":   dataTypeCounts,
+		"translations": translationCounts,
 	}, nil
 }
 
-// GetAllLanguageStats retrieves statistics for all available languages (only nouns and verbs).
-func GetAllLanguageStats() ([]models.LanguageStatisticsReponse, error) {
+// getTranslationCounts returns the number of translation entries from a language by target language.
+func getTranslationCounts(lang string) (map[string]int, error) {
+	tables, err := ListTranslationTables()
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []string
+	var args []any
+	for _, tableName := range tables {
+		target, source, ok := ParseTranslationTableName(tableName)
+		if !ok || source != strings.ToLower(lang) {
+			continue
+		}
+		counts = append(counts, fmt.Sprintf("SELECT ?, COUNT(*) FROM `%s`", tableName))
+		args = append(args, target)
+	}
+
+	if len(counts) == 0 {
+		return map[string]int{}, nil
+	}
+	return queryCounts(strings.Join(counts, " UNION ALL "), args...)
+}
+
+// queryCounts runs a query returning name and count pairs and collects them into a map.
+func queryCounts(query string, args ...any) (map[string]int, error) {
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var name string
+		var count int
+		if err := rows.Scan(&name, &count); err != nil {
+			return nil, fmt.Errorf("error scanning count: %w", err)
+		}
+		counts[name] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetAllLanguageStats retrieves statistics for all available languages, naming them in the language displayIn.
+func GetAllLanguageStats(displayIn language.Tag) ([]models.LanguageStatisticsReponse, error) {
 	availableLanguages, err := GetAvailableLanguages()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get available languages: %w", err)
@@ -154,7 +213,7 @@ func GetAllLanguageStats() ([]models.LanguageStatisticsReponse, error) {
 			continue
 		}
 
-		allStats = append(allStats, BuildLanguageStatResponse(lan, stat))
+		allStats = append(allStats, BuildLanguageStatResponse(lan, stat, displayIn))
 	}
 
 	return allStats, nil
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/scribe-org/scribe-server/internal/testdb"
+	"golang.org/x/text/language"
+)
+
+// useCatalogDB serves the package from an in-memory database whose tables are listed in information_schema.
+func useCatalogDB(t *testing.T, statements ...string) {
+	t.Helper()
+
+	previous := DB
+	DB = testdb.Open(t, statements...)
+	t.Cleanup(func() { DB = previous })
+}
+
+// MARK: Language Discovery
+
+func TestGetAvailableLanguagesWithThreeLetterCodes(t *testing.T) {
+	useCatalogDB(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT)",
+		"CREATE TABLE DAGLanguageDataNounsScribe (lexemeID TEXT)",
+		"CREATE TABLE DAGLanguageDataVerbsScribe (lexemeID TEXT)",
+		"CREATE TABLE ABCDLanguageDataNounsScribe (lexemeID TEXT)",
+		"CREATE TABLE TranslationDataENFromDAG (word TEXT)",
+	)
+
+	langs, err := GetAvailableLanguages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"dag", "de"}; !reflect.DeepEqual(langs, want) {
+		t.Errorf("GetAvailableLanguages() = %v, want %v", langs, want)
+	}
+
+	dataTypes, err := GetLanguageDataTypes("dag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"nouns", "verbs"}; !reflect.DeepEqual(dataTypes, want) {
+		t.Errorf("GetLanguageDataTypes(dag) = %v, want %v", dataTypes, want)
+	}
+}
+
+// MARK: Table Naming
+
+func TestLanguageTableNames(t *testing.T) {
+	tests := []struct {
+		lang, dataType string
+		want           string
+	}{
+		{"de", "nouns", "DELanguageDataNounsScribe"},
+		{"dag", "verbs", "DAGLanguageDataVerbsScribe"},
+		{"ha", "emojikeywords", "HALanguageDataEmojikeywordsScribe"},
+	}
+
+	for _, tt := range tests {
+		tableName := LanguageTableName(tt.lang, tt.dataType)
+		if tableName != tt.want || !IsValidTableName(tableName) {
+			t.Errorf("LanguageTableName(%s, %s) = %s, want the valid name %s", tt.lang, tt.dataType, tableName, tt.want)
+		}
+
+		lang, dataType, ok := ParseLanguageTableName(tableName)
+		if !ok || lang != tt.lang || dataType != tt.dataType {
+			t.Errorf("ParseLanguageTableName(%s) = %s, %s, %v", tableName, lang, dataType, ok)
+		}
+	}
+
+	for _, tableName := range []string{"ABCDLanguageDataNounsScribe", "DLanguageDataNounsScribe", "deLanguageDataNounsScribe", "DELanguageData_NounsScribe"} {
+		if IsValidTableName(tableName) {
+			t.Errorf("IsValidTableName(%s) = true", tableName)
+		}
+		if _, _, ok := ParseLanguageTableName(tableName); ok {
+			t.Errorf("ParseLanguageTableName(%s) succeeded", tableName)
+		}
+	}
+}
+
+// MARK: Language Statistics
+
+func TestGetLanguageStatCountsEveryDataType(t *testing.T) {
+	useCatalogDB(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT)",
+		"INSERT INTO DELanguageDataNounsScribe VALUES ('L1'), ('L2'), ('L3')",
+		"CREATE TABLE DELanguageDataVerbsScribe (lexemeID TEXT)",
+		"INSERT INTO DELanguageDataVerbsScribe VALUES ('L7')",
+		"CREATE TABLE DELanguageDataEmojikeywordsScribe (word TEXT, emoji TEXT)",
+		"INSERT INTO DELanguageDataEmojikeywordsScribe VALUES ('Herz', '❤️'), ('Katze', '🐈')",
+		"CREATE TABLE TranslationDataENFromDE (word TEXT)",
+		"INSERT INTO TranslationDataENFromDE VALUES ('Haus'), ('gehen')",
+		"CREATE TABLE TranslationDataDEFromEN (word TEXT)",
+		"INSERT INTO TranslationDataDEFromEN VALUES ('house')",
+	)
+
+	stat, err := GetLanguageStat("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := BuildLanguageStatResponse("de", stat, language.English)
+	if want := map[string]int{"nouns": 3, "verbs": 1, "emojikeywords": 2}; !reflect.DeepEqual(response.DataTypes, want) {
+		t.Errorf("data_types = %v, want %v", response.DataTypes, want)
+	}
+	if want := map[string]int{"en": 2}; !reflect.DeepEqual(response.Translations, want) {
+		t.Errorf("translations = %v, want %v", response.Translations, want)
+	}
+	if response.Nouns == nil || *response.Nouns != 3 || response.Verbs == nil || *response.Verbs != 1 {
+		t.Errorf("nouns = %v, verbs = %v, want 3 and 1", response.Nouns, response.Verbs)
+	}
+}
+
+func TestGetLanguageStatWithoutTables(t *testing.T) {
+	useCatalogDB(t)
+
+	stat, err := GetLanguageStat("fr")
+	if err != nil || stat != nil {
+		t.Errorf("GetLanguageStat(fr) = %v, %v, want no statistics", stat, err)
+	}
+
+	if _, err := GetLanguageStat("fr1"); err == nil {
+		t.Error("GetLanguageStat accepted an invalid language code")
+	}
+}
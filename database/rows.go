@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// MARK: Row Iteration
+
+// RowIterator walks the rows selected by a TableQuery one at a time,
+// so that callers can stream large tables without holding them in memory.
+// Every row is scanned into the same raw buffers, so reading a row allocates nothing
+// unless the caller asks for it as a map or slice.
+type RowIterator struct {
+	rows     *sql.Rows
+	columns  []string
+	values   []sql.RawBytes
+	scanArgs []any
+	keyIndex int
+	// Value of the key column, scanned separately as raw bytes cannot tell an empty string from NULL with every driver
+	key keyValue
+	// Index of the row ID among the scanned values, or -1 if the table has none
+	rowIDIndex int
+	limit      int
+	count      int
+	hasMore    bool
+	lastKey    []byte
+	lastKeyNil bool
+	lastRowID  []byte
+	err        error
+}
+
+// OpenTableRows runs q and returns an iterator over its rows.
+// The iterator must be closed once the caller is done with it.
+func OpenTableRows(q TableQuery) (*RowIterator, error) {
+	query, args, err := q.build()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error querying table data: %w", err)
+	}
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		rows.Close()
+		return nil, fmt.Errorf("error getting columns: %w", err)
+	}
+
+	it := &RowIterator{
+		rows:       rows,
+		columns:    make([]string, len(columnTypes)),
+		values:     make([]sql.RawBytes, len(columnTypes)),
+		scanArgs:   make([]any, len(columnTypes)),
+		rowIDIndex: -1,
+		limit:      q.Limit,
+	}
+	for i, columnType := range columnTypes {
+		it.columns[i] = columnType.Name()
+		it.scanArgs[i] = &it.values[i]
+	}
+
+	// The row ID is selected last and only used for the resume position, so it is hidden from the columns.
+	if q.HasRowID {
+		it.rowIDIndex = len(it.columns) - 1
+		it.columns = it.columns[:it.rowIDIndex]
+	}
+
+	it.keyIndex = slices.Index(it.columns, q.KeyColumn)
+	if it.keyIndex < 0 {
+		rows.Close()
+		return nil, fmt.Errorf("key column %s is not selected", q.KeyColumn)
+	}
+	// The buffer starts out non-nil, as a nil value stands for NULL.
+	it.key.value = make([]byte, 0, 32)
+	it.scanArgs[it.keyIndex] = &it.key
+
+	return it, nil
+}
+
+// keyValue scans a key column into a reused buffer, recording whether it is NULL.
+type keyValue struct {
+	value []byte
+	null  bool
+}
+
+// Scan implements sql.Scanner.
+func (k *keyValue) Scan(src any) error {
+	k.null = src == nil
+	switch v := src.(type) {
+	case nil:
+		k.value = k.value[:0]
+	case []byte:
+		k.value = append(k.value[:0], v...)
+	case string:
+		k.value = append(k.value[:0], v...)
+	default:
+		k.value = fmt.Append(k.value[:0], v)
+	}
+	return nil
+}
+
+// Next advances to the next row, returning false when the rows or the limit are exhausted or an error occurred.
+func (it *RowIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if !it.rows.Next() {
+		if err := it.rows.Err(); err != nil {
+			it.err = fmt.Errorf("error iterating rows: %w", err)
+		}
+		return false
+	}
+
+	// The query fetches one row beyond the limit to detect a following page.
+	if it.limit > 0 && it.count == it.limit {
+		it.hasMore = true
+		return false
+	}
+
+	if err := it.rows.Scan(it.scanArgs...); err != nil {
+		it.err = fmt.Errorf("error scanning row: %w", err)
+		return false
+	}
+
+	it.count++
+	it.values[it.keyIndex] = nil
+	if !it.key.null {
+		it.values[it.keyIndex] = it.key.value
+	}
+	it.lastKey = append(it.lastKey[:0], it.key.value...)
+	it.lastKeyNil = it.key.null
+	if it.rowIDIndex >= 0 {
+		it.lastRowID = append(it.lastRowID[:0], it.values[it.rowIDIndex]...)
+	}
+	return true
+}
+
+// Value returns the raw value of the column at index i of the current row, or nil if it is NULL.
+// The bytes are only valid until the next call to Next.
+func (it *RowIterator) Value(i int) []byte {
+	return it.values[i]
+}
+
+// Row returns a copy of the current row as a column-value map.
+func (it *RowIterator) Row() map[string]any {
+	row := make(map[string]any, len(it.columns))
+	for i, column := range it.columns {
+		row[column] = it.value(i)
+	}
+	return row
+}
+
+// Values returns a copy of the current row's values in column order.
+func (it *RowIterator) Values() []any {
+	values := make([]any, len(it.columns))
+	for i := range it.columns {
+		values[i] = it.value(i)
+	}
+	return values
+}
+
+// value converts the raw value of a column into a string, or nil if it is NULL.
+// Every column is rendered as a string, numbers included, as the data endpoints always have.
+func (it *RowIterator) value(i int) any {
+	if raw := it.values[i]; raw != nil {
+		return string(raw)
+	}
+	return nil
+}
+
+// Columns returns the column names of the rows in their selected order.
+func (it *RowIterator) Columns() []string {
+	return it.columns
+}
+
+// NextKey returns the encoded RowPosition to resume from once iteration stopped at the limit,
+// or an empty string if all rows were read.
+func (it *RowIterator) NextKey() string {
+	if !it.hasMore {
+		return ""
+	}
+
+	// Paged queries always select the row ID, an unsigned integer whose raw value always parses.
+	position := RowPosition{Key: string(it.lastKey), KeyNull: it.lastKeyNil}
+	position.RowID, _ = strconv.ParseInt(string(it.lastRowID), 10, 64)
+	return position.String()
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *RowIterator) Err() error {
+	return it.err
+}
+
+// Close releases the underlying result set.
+func (it *RowIterator) Close() error {
+	return it.rows.Close()
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LookupFormsTable is the index of lemmas and forms built by the migration from every language data table.
+// It maps each form to the row IDs of the rows holding it, so that word lookups do not scan the tables.
+const LookupFormsTable = "language_lookup_forms"
+
+// MARK: Form Lookup
+
+// FormLookup selects the rows of a language data table that the lookup index lists under a form.
+// Forms are matched with the collation of the index, which ignores case.
+type FormLookup struct {
+	// ISO code of the language the table belongs to
+	Language string
+	// Data type of the table, e.g. nouns
+	DataType string
+	// Lemma or form to look up
+	Form string
+}
+
+// compile renders the lookup as a condition on the row ID with its placeholder arguments.
+func (l FormLookup) compile() (string, []any) {
+	condition := fmt.Sprintf(
+		"`%s` IN (SELECT row_id FROM `%s` WHERE language_iso = ? AND data_type = ? AND form = ?)",
+		RowIDColumn, LookupFormsTable,
+	)
+	return condition, []any{strings.ToLower(l.Language), l.DataType, l.Form}
+}
+
+// MARK: Value Search
+
+// FindRowsByValue retrieves the rows of a table in which any of the given columns equals value.
+// Rows are ordered by keyColumn and at most limit rows are returned.
+func FindRowsByValue(tableName, keyColumn string, columns []string, value string, limit int) ([]map[string]any, error) {
+	if !IsValidDataTableName(tableName) {
+		return nil, fmt.Errorf("invalid table name")
+	}
+	if !IsValidColumnName(keyColumn) {
+		return nil, fmt.Errorf("invalid key column")
+	}
+	if len(columns) == 0 {
+		return []map[string]any{}, nil
+	}
+
+	conditions := make([]string, len(columns))
+	args := make([]any, 0, len(columns)+1)
+	for i, column := range columns {
+		if !IsValidColumnName(column) {
+			return nil, fmt.Errorf("invalid column name: %s", column)
+		}
+		conditions[i] = fmt.Sprintf("`%s` = ?", column)
+		args = append(args, value)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf("SELECT * FROM `%s` WHERE %s ORDER BY `%s` LIMIT ?",
+		tableName, strings.Join(conditions, " OR "), keyColumn)
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error searching table data: %w", err)
+	}
+	defer rows.Close()
+
+	results, err := scanRowMaps(rows)
+	if err != nil {
+		return nil, err
+	}
+	if results == nil {
+		results = []map[string]any{}
+	}
+
+	return results, nil
+}
+
+// IsTextColumnType reports whether a MySQL/MariaDB column type holds character data.
+func IsTextColumnType(columnType string) bool {
+	columnType = strings.ToLower(columnType)
+	return strings.Contains(columnType, "text") || strings.Contains(columnType, "char")
+}
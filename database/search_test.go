@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// MARK: Form Lookup
+
+func TestTableQueryBuildWithLookup(t *testing.T) {
+	lookup := &FormLookup{Language: "DE", DataType: "nouns", Form: "Häuser"}
+
+	query, args, err := TableQuery{
+		TableName: "DELanguageDataNounsScribe",
+		KeyColumn: LexemeIDColumn,
+		HasRowID:  true,
+		Lookup:    lookup,
+	}.build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	condition := "WHERE `rowID` IN (SELECT row_id FROM `language_lookup_forms` WHERE language_iso = ? AND data_type = ? AND form = ?)"
+	if !strings.Contains(query, condition) {
+		t.Errorf("query %q does not contain %q", query, condition)
+	}
+	if len(args) != 3 || args[0] != "de" || args[1] != "nouns" || args[2] != "Häuser" {
+		t.Errorf("args = %v, want the lowercased language, data type and form", args)
+	}
+
+	// Tables migrated before the row ID existed are not indexed.
+	_, _, err = TableQuery{TableName: "DELanguageDataNounsScribe", KeyColumn: LexemeIDColumn, Lookup: lookup}.build()
+	if !errors.Is(err, ErrPagingUnsupported) {
+		t.Errorf("build without row ID = %v, want %v", err, ErrPagingUnsupported)
+	}
+}
+
+func TestOpenTableRowsLooksUpIndexedForms(t *testing.T) {
+	openTestDB(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, singular TEXT, plural TEXT)",
+		"INSERT INTO DELanguageDataNounsScribe VALUES ('L1', 'Haus', 'Häuser'), ('L2', 'Maus', 'Mäuse'), ('L3', 'Haus', NULL)",
+		"CREATE TABLE language_lookup_forms (language_iso TEXT, form TEXT COLLATE NOCASE, data_type TEXT, row_id INTEGER)",
+		`INSERT INTO language_lookup_forms VALUES
+			('de', 'Haus', 'nouns', 1), ('de', 'Häuser', 'nouns', 1), ('de', 'Maus', 'nouns', 2),
+			('de', 'Mäuse', 'nouns', 2), ('de', 'Haus', 'nouns', 3), ('de', 'Haus', 'verbs', 2), ('fr', 'Maus', 'nouns', 1)`,
+	)
+
+	tests := []struct {
+		lookup FormLookup
+		want   string
+	}{
+		{FormLookup{"de", "nouns", "Haus"}, "L1,L3"},
+		{FormLookup{"de", "nouns", "haus"}, "L1,L3"},
+		{FormLookup{"de", "nouns", "Mäuse"}, "L2"},
+		{FormLookup{"de", "nouns", "Hau"}, ""},
+		{FormLookup{"fr", "nouns", "Haus"}, ""},
+	}
+
+	for _, tt := range tests {
+		lookup := tt.lookup
+		rows, err := OpenTableRows(TableQuery{
+			TableName: "DELanguageDataNounsScribe",
+			KeyColumn: LexemeIDColumn,
+			HasRowID:  true,
+			Lookup:    &lookup,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var ids []string
+		for rows.Next() {
+			ids = append(ids, rows.Row()[LexemeIDColumn].(string))
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		rows.Close()
+
+		if got := strings.Join(ids, ","); got != tt.want {
+			t.Errorf("lookup %+v matched %q, want %q", tt.lookup, got, tt.want)
+		}
+	}
+}
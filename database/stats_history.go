@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scribe-org/scribe-server/internal/languages"
+	"github.com/scribe-org/scribe-server/models"
+	"golang.org/x/text/language"
+)
+
+// StatsHistoryTable is the table in which the migration stores a snapshot of the row counts after every run.
+const StatsHistoryTable = "language_stats_history"
+
+// MARK: Get Stats History
+
+// GetStatsHistory returns the recorded row counts of the given languages, or all languages if codes is empty,
+// between from and to. Zero times leave the range open. Snapshots are ordered by time within each language,
+// and languages are named in displayIn. Databases that were migrated before snapshots were recorded yield no results.
+func GetStatsHistory(codes []string, from, to time.Time, displayIn language.Tag) ([]models.LanguageStatsHistory, error) {
+	exists, err := TableExists(StatsHistoryTable)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []models.LanguageStatsHistory{}, nil
+	}
+
+	var conditions []string
+	var args []any
+
+	if len(codes) > 0 {
+		conditions = append(conditions, "language_iso IN ("+strings.TrimSuffix(strings.Repeat("?, ", len(codes)), ", ")+")")
+		for _, code := range codes {
+			args = append(args, strings.ToLower(code))
+		}
+	}
+	if !from.IsZero() {
+		conditions = append(conditions, "recorded_at >= ?")
+		args = append(args, from)
+	}
+	if !to.IsZero() {
+		conditions = append(conditions, "recorded_at <= ?")
+		args = append(args, to)
+	}
+
+	query := fmt.Sprintf("SELECT language_iso, recorded_at, data_type, row_count FROM %s", StatsHistoryTable)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY language_iso, recorded_at, data_type"
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error querying statistics history: %w", err)
+	}
+	defer rows.Close()
+
+	history := []models.LanguageStatsHistory{}
+	for rows.Next() {
+		var code, dataType string
+		var recordedAt time.Time
+		var rowCount int
+		if err := rows.Scan(&code, &recordedAt, &dataType, &rowCount); err != nil {
+			return nil, fmt.Errorf("error scanning statistics snapshot: %w", err)
+		}
+
+		if len(history) == 0 || history[len(history)-1].Code != code {
+			history = append(history, models.LanguageStatsHistory{
+				Code:             code,
+				LanguageMetadata: languages.Metadata(code, displayIn),
+				Snapshots:        []models.StatsSnapshot{},
+			})
+		}
+		entry := &history[len(history)-1]
+
+		timestamp := recordedAt.UTC().Format(time.RFC3339)
+		if len(entry.Snapshots) == 0 || entry.Snapshots[len(entry.Snapshots)-1].RecordedAt != timestamp {
+			entry.Snapshots = append(entry.Snapshots, models.StatsSnapshot{RecordedAt: timestamp, DataTypes: map[string]int{}})
+		}
+		entry.Snapshots[len(entry.Snapshots)-1].DataTypes[dataType] = rowCount
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating statistics history: %w", err)
+	}
+
+	return history, nil
+}
@@ -3,7 +3,13 @@
 package database
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -33,18 +39,18 @@ func TableExists(tableName string) (bool, error) {
 // GetTableSchema returns the column names and types for a specific table
 // in the connected MySQL/MariaDB database.
 func GetTableSchema(tableName string) (map[string]string, error) {
-	if !IsValidTableName(tableName) {
+	if !IsValidDataTableName(tableName) {
 		return nil, fmt.Errorf("invalid table name")
 	}
 
 	query := `
 		SELECT COLUMN_NAME, COLUMN_TYPE
 		FROM information_schema.COLUMNS
-		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND COLUMN_NAME <> ?
 		ORDER BY ORDINAL_POSITION
 	`
 
-	rows, err := DB.Query(query, viper.GetString("database.name"), tableName)
+	rows, err := DB.Query(query, viper.GetString("database.name"), tableName, RowIDColumn)
 	if err != nil {
 		return nil, fmt.Errorf("error querying table schema: %w", err)
 	}
@@ -62,53 +68,313 @@ func GetTableSchema(tableName string) (map[string]string, error) {
 	return schema, nil
 }
 
-// MARK: Data Retrieval
+// MARK: Column Inspection
 
-// GetTableData retrieves all rows and columns from a given table.
-// The result is a slice of maps, where each map represents a row with column-value pairs.
-func GetTableData(tableName string) ([]map[string]any, error) {
-	if !IsValidTableName(tableName) {
+// GetTableColumns returns the column names for a specific table in their ordinal order.
+func GetTableColumns(tableName string) ([]string, error) {
+	if !IsValidDataTableName(tableName) {
 		return nil, fmt.Errorf("invalid table name")
 	}
 
-	query := fmt.Sprintf("SELECT * FROM %s", tableName)
+	query := `
+		SELECT COLUMN_NAME
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND COLUMN_NAME <> ?
+		ORDER BY ORDINAL_POSITION
+	`
 
-	rows, err := DB.Query(query)
+	rows, err := DB.Query(query, viper.GetString("database.name"), tableName, RowIDColumn)
 	if err != nil {
-		return nil, fmt.Errorf("error querying table data: %w", err)
+		return nil, fmt.Errorf("error querying table columns: %w", err)
 	}
 	defer rows.Close()
 
-	columns, err := rows.Columns()
-	if err != nil {
-		return nil, fmt.Errorf("error getting columns: %w", err)
+	var columns []string
+	for rows.Next() {
+		var columnName string
+		if err := rows.Scan(&columnName); err != nil {
+			return nil, fmt.Errorf("error scanning column name: %w", err)
+		}
+		columns = append(columns, columnName)
 	}
 
-	var results []map[string]any
+	return columns, nil
+}
+
+// GetTablesWithColumn returns the names of the tables in the database that have a column with the given name.
+func GetTablesWithColumn(columnName string) ([]string, error) {
+	query := `
+		SELECT TABLE_NAME
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND COLUMN_NAME = ?
+		ORDER BY TABLE_NAME
+	`
 
+	rows, err := DB.Query(query, viper.GetString("database.name"), columnName)
+	if err != nil {
+		return nil, fmt.Errorf("error querying tables with column %s: %w", columnName, err)
+	}
+	defer rows.Close()
+
+	var tables []string
 	for rows.Next() {
-		values := make([]any, len(columns))
-		valuePtrs := make([]any, len(columns))
-		for i := range columns {
-			valuePtrs[i] = &values[i]
+		var tableName string
+		if err := rows.Scan(&tableName); err != nil {
+			return nil, fmt.Errorf("error scanning table name: %w", err)
 		}
-		if err := rows.Scan(valuePtrs...); err != nil {
-			return nil, fmt.Errorf("error scanning row: %w", err)
+		tables = append(tables, tableName)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tables: %w", err)
+	}
+
+	return tables, nil
+}
+
+// HasRowID reports whether a table has the row ID column, which tables migrated by older versions lack.
+func HasRowID(tableName string) (bool, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND COLUMN_NAME = ?
+	`
+
+	var count int
+	if err := DB.QueryRow(query, viper.GetString("database.name"), tableName, RowIDColumn).Scan(&count); err != nil {
+		return false, fmt.Errorf("error checking row ID column: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// GetTableKeyColumn returns the column used to order and page through a table.
+// The lexeme ID is preferred, otherwise the first column is used. Keys may repeat, see RowPosition.
+func GetTableKeyColumn(tableName string) (string, error) {
+	columns, err := GetTableColumns(tableName)
+	if err != nil {
+		return "", err
+	}
+	if len(columns) == 0 {
+		return "", fmt.Errorf("table %s has no columns", tableName)
+	}
+
+	return KeyColumnOf(columns), nil
+}
+
+// KeyColumnOf picks the key column from a table's ordered, non-empty column list.
+func KeyColumnOf(columns []string) string {
+	if slices.Contains(columns, LexemeIDColumn) {
+		return LexemeIDColumn
+	}
+	return columns[0]
+}
+
+// MARK: Query Retrieval
+
+// ErrPagingUnsupported is returned for paged queries and form lookups on tables migrated before the row ID column existed,
+// as their rows can neither be ordered unambiguously nor be referenced by the lookup index. Such tables need to be migrated again.
+var ErrPagingUnsupported = errors.New("table has no row ID column to page by")
+
+// RowPosition is the place of a row in the key order of a table.
+// Several rows may share a key, e.g. the forms of one lexeme, so the row ID breaks ties.
+type RowPosition struct {
+	// Value of the key column, empty if it is NULL
+	Key string
+	// Whether the key column is NULL, which sorts before every other key
+	KeyNull bool
+	// Value of the row ID column
+	RowID int64
+}
+
+// String encodes the position as "<rowID>:<key>" for use in cursors, or as "<rowID>" if the key is NULL.
+func (p RowPosition) String() string {
+	id := strconv.FormatInt(p.RowID, 10)
+	if p.KeyNull {
+		return id
+	}
+	return id + ":" + p.Key
+}
+
+// ParseRowPosition decodes a position encoded by RowPosition.String.
+func ParseRowPosition(s string) (RowPosition, error) {
+	id, key, hasKey := strings.Cut(s, ":")
+	rowID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || rowID < 0 {
+		return RowPosition{}, fmt.Errorf("invalid row ID in row position")
+	}
+
+	return RowPosition{Key: key, KeyNull: !hasKey, RowID: rowID}, nil
+}
+
+// TableQuery describes a selection of rows ordered by a key column.
+type TableQuery struct {
+	// Name of the table to read from
+	TableName string
+	// Column the rows are ordered and paged by
+	KeyColumn string
+	// Whether the table has the row ID column, which then breaks ties between rows with the same key
+	HasRowID bool
+	// Whether to continue after the After position rather than start from the first row
+	Resumed bool
+	// Position of the last row of the previous page, only used if Resumed is set
+	After RowPosition
+	// Maximum number of rows to return, zero for no limit
+	Limit int
+	// Only return rows changed after this time as told by SinceModified and SinceUpserted, zero for all rows
+	Since time.Time
+	// Whether rows whose lastModified is later than Since count as changed
+	SinceModified bool
+	// Whether rows whose lexeme a migration recorded as added or changed after Since count as changed
+	SinceUpserted bool
+	// Columns to select in order, empty for all columns
+	Columns []string
+	// Conditions all returned rows must meet
+	Filters []Filter
+	// Only return the rows the lookup index lists under a form, nil for all rows
+	Lookup *FormLookup
+}
+
+// build validates the query and renders it as parameterized SQL.
+func (q TableQuery) build() (string, []any, error) {
+	if !IsValidTableName(q.TableName) {
+		return "", nil, fmt.Errorf("invalid table name")
+	}
+	if !IsValidColumnName(q.KeyColumn) {
+		return "", nil, fmt.Errorf("invalid key column")
+	}
+	if q.Limit < 0 {
+		return "", nil, fmt.Errorf("invalid limit: %d", q.Limit)
+	}
+	if (q.Limit > 0 || q.Resumed || q.Lookup != nil) && !q.HasRowID {
+		return "", nil, ErrPagingUnsupported
+	}
+
+	var conditions []string
+	var args []any
+
+	// NULL keys sort first, so rows after a NULL key are the remaining NULL keys and every other key.
+	if q.Resumed {
+		if q.After.KeyNull {
+			conditions = append(conditions, fmt.Sprintf("((`%s` IS NULL AND `%s` > ?) OR `%s` IS NOT NULL)", q.KeyColumn, RowIDColumn, q.KeyColumn))
+			args = append(args, q.After.RowID)
+		} else {
+			conditions = append(conditions, fmt.Sprintf("(`%s`, `%s`) > (?, ?)", q.KeyColumn, RowIDColumn))
+			args = append(args, q.After.Key, q.After.RowID)
 		}
+	}
+	if !q.Since.IsZero() && (q.SinceModified || q.SinceUpserted) {
+		var changed []string
+		if q.SinceModified {
+			changed = append(changed, fmt.Sprintf("`%s` > ?", LastModifiedColumn))
+			args = append(args, q.Since)
+		}
+		if q.SinceUpserted {
+			changed = append(changed, fmt.Sprintf(
+				"`%s` IN (SELECT lexeme_id FROM `%s` WHERE table_name = ? AND upserted_at > ?)",
+				LexemeIDColumn, UpsertsTable,
+			))
+			args = append(args, q.TableName, q.Since)
+		}
+		conditions = append(conditions, "("+strings.Join(changed, " OR ")+")")
+	}
+	if q.Lookup != nil {
+		condition, lookupArgs := q.Lookup.compile()
+		conditions = append(conditions, condition)
+		args = append(args, lookupArgs...)
+	}
+	for _, filter := range q.Filters {
+		condition, filterArgs, err := filter.compile()
+		if err != nil {
+			return "", nil, err
+		}
+		conditions = append(conditions, condition)
+		args = append(args, filterArgs...)
+	}
 
-		rowMap := make(map[string]any)
-		for i, col := range columns {
-			val := values[i]
-			if val == nil {
-				rowMap[col] = nil
-			} else if b, ok := val.([]byte); ok {
-				rowMap[col] = string(b)
-			} else {
-				rowMap[col] = val
+	selection := "*"
+	if len(q.Columns) > 0 {
+		for _, column := range q.Columns {
+			if !IsValidColumnName(column) {
+				return "", nil, fmt.Errorf("invalid column name: %s", column)
 			}
 		}
+		selection = "`" + strings.Join(q.Columns, "`, `") + "`"
+	}
+
+	// The invisible row ID is left out of *, so it is selected explicitly as the last column.
+	order := fmt.Sprintf("`%s`", q.KeyColumn)
+	if q.HasRowID {
+		selection += fmt.Sprintf(", `%s`", RowIDColumn)
+		order += fmt.Sprintf(", `%s`", RowIDColumn)
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM `%s`", selection, q.TableName)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY " + order
+
+	// Fetch one extra row to know whether another page follows.
+	if q.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, q.Limit+1)
+	}
+
+	return query, args, nil
+}
+
+// MARK: Row Scanning
+
+// scanRowMaps reads all remaining rows into column-value maps, converting byte slices to strings.
+// The row ID column is left out, as it is internal to the server.
+func scanRowMaps(rows *sql.Rows) ([]map[string]any, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("error getting columns: %w", err)
+	}
+
+	var results []map[string]any
+
+	for rows.Next() {
+		rowMap, err := scanRowMap(rows, columns)
+		if err != nil {
+			return nil, err
+		}
 		results = append(results, rowMap)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
 	return results, nil
 }
+
+// scanRowMap reads the current row into a column-value map, converting byte slices to strings.
+func scanRowMap(rows *sql.Rows, columns []string) (map[string]any, error) {
+	values := make([]any, len(columns))
+	valuePtrs := make([]any, len(columns))
+	for i := range columns {
+		valuePtrs[i] = &values[i]
+	}
+	if err := rows.Scan(valuePtrs...); err != nil {
+		return nil, fmt.Errorf("error scanning row: %w", err)
+	}
+
+	rowMap := make(map[string]any)
+	for i, col := range columns {
+		val := values[i]
+		if col == RowIDColumn {
+			continue
+		} else if val == nil {
+			rowMap[col] = nil
+		} else if b, ok := val.([]byte); ok {
+			rowMap[col] = string(b)
+		} else {
+			rowMap[col] = val
+		}
+	}
+
+	return rowMap, nil
+}
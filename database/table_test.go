@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/glebarez/sqlite"
+)
+
+// openTestDB replaces DB with an in-memory SQLite database for the duration of a test.
+// SQLite exposes an implicit rowid, which stands in for the invisible row ID column of MariaDB tables.
+func openTestDB(t *testing.T, statements ...string) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("opening test database: %v", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("preparing test database: %v", err)
+		}
+	}
+
+	previous := DB
+	DB = db
+	t.Cleanup(func() {
+		DB = previous
+		db.Close()
+	})
+}
+
+// MARK: Row Positions
+
+func TestRowPositionRoundTrip(t *testing.T) {
+	positions := []RowPosition{
+		{Key: "L123", RowID: 7},
+		{Key: "with:colons", RowID: 1},
+		{Key: "", RowID: 3},
+		{KeyNull: true, RowID: 9},
+		{Key: "L5"},
+	}
+
+	for _, want := range positions {
+		got, err := ParseRowPosition(want.String())
+		if err != nil {
+			t.Fatalf("ParseRowPosition(%q): %v", want.String(), err)
+		}
+		if got != want {
+			t.Errorf("ParseRowPosition(%q) = %+v, want %+v", want.String(), got, want)
+		}
+	}
+}
+
+func TestParseRowPositionRejectsMalformed(t *testing.T) {
+	for _, s := range []string{"", ":L123", "L123", "x:L123", "-1:L123", "1.5:L123", "99999999999999999999:L1"} {
+		if _, err := ParseRowPosition(s); err == nil {
+			t.Errorf("ParseRowPosition(%q) succeeded, want error", s)
+		}
+	}
+}
+
+// MARK: Query Building
+
+func TestTableQueryBuildOrdersByKeyAndRowID(t *testing.T) {
+	query, args, err := TableQuery{
+		TableName: "DELanguageDataNounsScribe",
+		KeyColumn: LexemeIDColumn,
+		HasRowID:  true,
+		Resumed:   true,
+		After:     RowPosition{Key: "L1", RowID: 4},
+		Limit:     2,
+	}.build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, part := range []string{"SELECT *, `rowID`", "(`lexemeID`, `rowID`) > (?, ?)", "ORDER BY `lexemeID`, `rowID`", "LIMIT ?"} {
+		if !strings.Contains(query, part) {
+			t.Errorf("query %q does not contain %q", query, part)
+		}
+	}
+	if want := []any{"L1", int64(4), 3}; fmt.Sprint(args) != fmt.Sprint(want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+func TestTableQueryBuildResumesAfterEmptyAndNullKeys(t *testing.T) {
+	tests := []struct {
+		after     RowPosition
+		condition string
+		args      []any
+	}{
+		{RowPosition{RowID: 5}, "(`lexemeID`, `rowID`) > (?, ?)", []any{"", int64(5)}},
+		{RowPosition{KeyNull: true, RowID: 5}, "((`lexemeID` IS NULL AND `rowID` > ?) OR `lexemeID` IS NOT NULL)", []any{int64(5)}},
+	}
+
+	for _, tt := range tests {
+		query, args, err := TableQuery{
+			TableName: "DELanguageDataNounsScribe",
+			KeyColumn: LexemeIDColumn,
+			HasRowID:  true,
+			Resumed:   true,
+			After:     tt.after,
+		}.build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(query, "WHERE "+tt.condition) || fmt.Sprint(args) != fmt.Sprint(tt.args) {
+			t.Errorf("after %+v: query %q with args %v, want condition %q with args %v", tt.after, query, args, tt.condition, tt.args)
+		}
+	}
+}
+
+func TestTableQueryBuildWithoutRowID(t *testing.T) {
+	query, args, err := TableQuery{
+		TableName: "DELanguageDataNounsScribe",
+		KeyColumn: LexemeIDColumn,
+	}.build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(query, RowIDColumn) || len(args) != 0 {
+		t.Errorf("query %q with args %v uses the row ID of a table without one", query, args)
+	}
+
+	for _, q := range []TableQuery{
+		{TableName: "DELanguageDataNounsScribe", KeyColumn: LexemeIDColumn, Limit: 10},
+		{TableName: "DELanguageDataNounsScribe", KeyColumn: LexemeIDColumn, Resumed: true, After: RowPosition{Key: "L1"}},
+	} {
+		if _, _, err := q.build(); !errors.Is(err, ErrPagingUnsupported) {
+			t.Errorf("paged query %+v without row ID: error %v, want %v", q, err, ErrPagingUnsupported)
+		}
+	}
+}
+
+// MARK: Delta Sync
+
+func TestTableQueryBuildSinceConditions(t *testing.T) {
+	since := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	upserted := "`lexemeID` IN (SELECT lexeme_id FROM `language_data_upserts` WHERE table_name = ? AND upserted_at > ?)"
+
+	tests := []struct {
+		modified, upserted bool
+		condition          string
+		args               []any
+	}{
+		{true, false, "WHERE (`lastModified` > ?)", []any{since}},
+		{false, true, "WHERE (" + upserted + ")", []any{"DELanguageDataNounsScribe", since}},
+		{true, true, "WHERE (`lastModified` > ? OR " + upserted + ")", []any{since, "DELanguageDataNounsScribe", since}},
+		{false, false, "", nil},
+	}
+
+	for _, tt := range tests {
+		query, args, err := TableQuery{
+			TableName:     "DELanguageDataNounsScribe",
+			KeyColumn:     LexemeIDColumn,
+			Since:         since,
+			SinceModified: tt.modified,
+			SinceUpserted: tt.upserted,
+		}.build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.condition == "" && strings.Contains(query, "WHERE") {
+			t.Errorf("modified %v, upserted %v: query %q is filtered, want all rows", tt.modified, tt.upserted, query)
+		}
+		if !strings.Contains(query, tt.condition) || fmt.Sprint(args) != fmt.Sprint(tt.args) {
+			t.Errorf("modified %v, upserted %v: query %q with args %v, want %q with %v", tt.modified, tt.upserted, query, args, tt.condition, tt.args)
+		}
+	}
+}
+
+func TestOpenTableRowsServesUpsertedRowsToDeltas(t *testing.T) {
+	openTestDB(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, singular TEXT, lastModified TIMESTAMP)",
+		"CREATE TABLE language_data_upserts (table_name TEXT, lexeme_id TEXT, upserted_at TIMESTAMP)",
+	)
+
+	old := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	migratedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	for _, statement := range []struct {
+		query string
+		args  []any
+	}{
+		// L1 is unchanged, L2 was edited on Wikidata and L3 restored by a migration with its old modification time.
+		{"INSERT INTO DELanguageDataNounsScribe VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)", []any{"L1", "Haus", old, "L2", "Baum", migratedAt, "L3", "Tisch", old}},
+		{"INSERT INTO language_data_upserts VALUES (?, ?, ?), (?, ?, ?)", []any{"DELanguageDataNounsScribe", "L3", migratedAt, "DELanguageDataNounsScribe", "L1", old}},
+	} {
+		if _, err := DB.Exec(statement.query, statement.args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := OpenTableRows(TableQuery{
+		TableName:     "DELanguageDataNounsScribe",
+		KeyColumn:     LexemeIDColumn,
+		Since:         since,
+		SinceModified: true,
+		SinceUpserted: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		ids = append(ids, rows.Row()[LexemeIDColumn].(string))
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(ids, ","); got != "L2,L3" {
+		t.Errorf("delta rows = %s, want L2,L3", got)
+	}
+}
+
+// MARK: Paging
+
+func TestOpenTableRowsPagesThroughRepeatedKeys(t *testing.T) {
+	openTestDB(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, form TEXT)",
+		`INSERT INTO DELanguageDataNounsScribe VALUES
+			('L2', 'b1'), ('L1', 'a1'), ('L2', 'b2'), ('L1', 'a2'), ('L2', 'b3'), ('L3', 'c1'), ('L1', 'a3')`,
+	)
+
+	pageThrough(t, "a1,a2,a3,b1,b2,b3,c1")
+}
+
+func TestOpenTableRowsPagesThroughEmptyAndNullKeys(t *testing.T) {
+	openTestDB(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID TEXT, form TEXT)",
+		`INSERT INTO DELanguageDataNounsScribe VALUES
+			('', 'e1'), (NULL, 'n1'), ('L1', 'a1'), (NULL, 'n2'), ('', 'e2'), (NULL, 'n3')`,
+	)
+
+	pageThrough(t, "n1,n2,n3,e1,e2,a1")
+
+	rows, err := OpenTableRows(TableQuery{TableName: "DELanguageDataNounsScribe", KeyColumn: LexemeIDColumn, HasRowID: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var keys []any
+	for rows.Next() {
+		keys = append(keys, rows.Row()[LexemeIDColumn])
+	}
+	if want := []any{nil, nil, nil, "", "", "L1"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %#v, want %#v", keys, want)
+	}
+}
+
+// pageThrough reads the test table page by page for several page sizes and compares the forms read with want.
+func pageThrough(t *testing.T, want string) {
+	t.Helper()
+
+	for limit := 1; limit <= 4; limit++ {
+		var forms []string
+		var after RowPosition
+		for page := 0; ; page++ {
+			if page > 10 {
+				t.Fatalf("limit %d: paging does not terminate", limit)
+			}
+
+			rows, err := OpenTableRows(TableQuery{
+				TableName: "DELanguageDataNounsScribe",
+				KeyColumn: LexemeIDColumn,
+				HasRowID:  true,
+				Resumed:   page > 0,
+				After:     after,
+				Limit:     limit,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := rows.Columns(); fmt.Sprint(got) != "[lexemeID form]" {
+				t.Fatalf("columns = %v, want the row ID hidden", got)
+			}
+			for rows.Next() {
+				forms = append(forms, rows.Row()["form"].(string))
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			next := rows.NextKey()
+			rows.Close()
+
+			if next == "" {
+				break
+			}
+			if after, err = ParseRowPosition(next); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if got := strings.Join(forms, ","); got != want {
+			t.Errorf("limit %d: paged forms = %s, want %s", limit, got, want)
+		}
+	}
+}
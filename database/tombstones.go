@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// TombstonesTable is the table in which the migration records rows removed from language data tables.
+const TombstonesTable = "language_data_tombstones"
+
+// UpsertsTable is the table in which the migration records the lexemes whose rows it added or changed.
+const UpsertsTable = "language_data_upserts"
+
+// MARK: Get Tombstones
+
+// GetTombstones returns the lexeme IDs removed from a language data table after the given time.
+// Databases that were migrated before tombstones were recorded yield no results.
+func GetTombstones(tableName string, since time.Time) ([]string, error) {
+	if !IsValidTableName(tableName) {
+		return nil, fmt.Errorf("invalid table name")
+	}
+
+	exists, err := TableExists(TombstonesTable)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []string{}, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT lexeme_id
+		FROM %s
+		WHERE table_name = ? AND deleted_at > ?
+		ORDER BY lexeme_id
+	`, TombstonesTable)
+
+	rows, err := DB.Query(query, tableName, since)
+	if err != nil {
+		return nil, fmt.Errorf("error querying tombstones: %w", err)
+	}
+	defer rows.Close()
+
+	lexemeIDs := []string{}
+	for rows.Next() {
+		var lexemeID string
+		if err := rows.Scan(&lexemeID); err != nil {
+			return nil, fmt.Errorf("error scanning tombstone: %w", err)
+		}
+		lexemeIDs = append(lexemeIDs, lexemeID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tombstones: %w", err)
+	}
+
+	return lexemeIDs, nil
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/scribe-org/scribe-server/models"
+	"github.com/spf13/viper"
+)
+
+// MARK: Translation Tables
+
+// ListTranslationTables returns the names of all translation tables in the database.
+func ListTranslationTables() ([]string, error) {
+	query := `
+		SELECT TABLE_NAME
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = ?
+		AND TABLE_NAME LIKE 'TranslationData%From%'
+		ORDER BY TABLE_NAME
+	`
+
+	rows, err := DB.Query(query, viper.GetString("database.name"))
+	if err != nil {
+		return nil, fmt.Errorf("error querying translation tables: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var tableName string
+		if err := rows.Scan(&tableName); err != nil {
+			return nil, fmt.Errorf("error scanning translation table: %w", err)
+		}
+		if IsValidTranslationTableName(tableName) {
+			tables = append(tables, tableName)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating translation tables: %w", err)
+	}
+
+	return tables, nil
+}
+
+// MARK: Translation Pairs
+
+// GetTranslationMigrationTimes returns when each translation table was last migrated, by table name.
+// Tables whose migration time is unknown map to a zero time. Unlike counting their rows, this only reads information_schema.
+func GetTranslationMigrationTimes() (map[string]time.Time, error) {
+	query := `
+		SELECT TABLE_NAME, CREATE_TIME
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = ?
+		AND TABLE_NAME LIKE 'TranslationData%From%'
+	`
+
+	rows, err := DB.Query(query, viper.GetString("database.name"))
+	if err != nil {
+		return nil, fmt.Errorf("error querying translation migration times: %w", err)
+	}
+	defer rows.Close()
+
+	times := make(map[string]time.Time)
+	for rows.Next() {
+		var tableName string
+		var createdAt sql.NullTime
+		if err := rows.Scan(&tableName, &createdAt); err != nil {
+			return nil, fmt.Errorf("error scanning translation migration time: %w", err)
+		}
+		if IsValidTranslationTableName(tableName) {
+			times[tableName] = createdAt.Time
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating translation migration times: %w", err)
+	}
+
+	return times, nil
+}
+
+// GetTranslationPairs lists the language pairs of the given translation tables, along with their row counts,
+// word types and the time their table was last migrated, as returned by GetTranslationMigrationTimes.
+// Pairs are ordered by table name, i.e. by target and then source language.
+func GetTranslationPairs(migrationTimes map[string]time.Time) ([]models.TranslationPair, error) {
+	pairs := []models.TranslationPair{}
+	for _, tableName := range slices.Sorted(maps.Keys(migrationTimes)) {
+		targetLang, sourceLang, ok := ParseTranslationTableName(tableName)
+		if !ok {
+			continue
+		}
+
+		pair, err := getTranslationPair(tableName)
+		if err != nil {
+			return nil, err
+		}
+
+		pair.SourceLang = sourceLang
+		pair.TargetLang = targetLang
+		if migratedAt := migrationTimes[tableName]; !migratedAt.IsZero() {
+			lastMigrated := migratedAt.UTC().Format(time.RFC3339)
+			pair.LastMigrated = &lastMigrated
+		}
+		pairs = append(pairs, pair)
+	}
+
+	return pairs, nil
+}
+
+// getTranslationPair counts the rows of a translation table per word type.
+func getTranslationPair(tableName string) (models.TranslationPair, error) {
+	query := fmt.Sprintf("SELECT wordType, COUNT(*) FROM `%s` GROUP BY wordType ORDER BY wordType", tableName)
+
+	rows, err := DB.Query(query)
+	if err != nil {
+		return models.TranslationPair{}, fmt.Errorf("error counting rows of %s: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	pair := models.TranslationPair{WordTypes: map[string]int{}}
+	for rows.Next() {
+		var wordType string
+		var count int
+		if err := rows.Scan(&wordType, &count); err != nil {
+			return models.TranslationPair{}, fmt.Errorf("error scanning word type count: %w", err)
+		}
+		pair.WordTypes[wordType] = count
+		pair.RowCount += count
+	}
+
+	if err := rows.Err(); err != nil {
+		return models.TranslationPair{}, fmt.Errorf("error iterating word type counts: %w", err)
+	}
+
+	return pair, nil
+}
@@ -3,19 +3,72 @@
 package database
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/scribe-org/scribe-server/internal/constants"
+	"github.com/scribe-org/scribe-server/internal/languages"
 	"github.com/scribe-org/scribe-server/models"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
+const (
+	// LexemeIDColumn is the column holding the Wikidata lexeme ID in Scribe-Data tables.
+	LexemeIDColumn = "lexemeID"
+	// LastModifiedColumn is the column holding the Wikidata modification time of a row.
+	LastModifiedColumn = "lastModified"
+	// RowIDColumn is the invisible auto-increment column the migration adds to every table to identify rows uniquely.
+	// It is left out of SELECT * and of the columns and schemas reported for a table.
+	RowIDColumn = "rowID"
+)
+
+// MARK: Table Naming
+
+// LanguageTableName builds the table name for a language data type, e.g. en + nouns -> ENLanguageDataNounsScribe.
+func LanguageTableName(lang, dataType string) string {
+	caser := cases.Title(language.English)
+
+	return fmt.Sprintf("%sLanguageData%sScribe",
+		strings.ToUpper(lang),
+		caser.String(dataType),
+	)
+}
+
+// languageCodePattern matches the ISO 639-1 and ISO 639-3 codes language data tables are named with.
+var languageCodePattern = regexp.MustCompile(`^[A-Za-z]{2,3}$`)
+
+// languageTablePattern splits a language data table name into its language and data type.
+var languageTablePattern = regexp.MustCompile(`^([A-Z]{2,3})LanguageData([A-Za-z]+)Scribe$`)
+
+// translationTablePattern splits a translation table name into its target and source languages.
+var translationTablePattern = regexp.MustCompile(`^TranslationData([A-Z]{2,4})From([A-Z]{2,4})$`)
+
+// ParseLanguageTableName returns the lowercase language code and data type of a language data table name.
+func ParseLanguageTableName(tableName string) (string, string, bool) {
+	matches := languageTablePattern.FindStringSubmatch(tableName)
+	if matches == nil {
+		return "", "", false
+	}
+	return strings.ToLower(matches[1]), strings.ToLower(matches[2]), true
+}
+
+// ParseTranslationTableName returns the lowercase target and source language codes of a translation table name.
+func ParseTranslationTableName(tableName string) (string, string, bool) {
+	matches := translationTablePattern.FindStringSubmatch(tableName)
+	if matches == nil {
+		return "", "", false
+	}
+	return strings.ToLower(matches[1]), strings.ToLower(matches[2]), true
+}
+
 // MARK: Validation Helpers
 
 // IsValidTableName validates table names to prevent SQL injection.
 func IsValidTableName(tableName string) bool {
-	// Pattern to match the new table structure: ENLanguageDataNounsScribe.
-	pattern := `^[A-Z]{2}LanguageData[A-Za-z]+Scribe$`
+	// Pattern to match the new table structure: ENLanguageDataNounsScribe or DAGLanguageDataNounsScribe.
+	pattern := `^[A-Z]{2,3}LanguageData[A-Za-z]+Scribe$`
 	matched, err := regexp.MatchString(pattern, tableName)
 	if err != nil {
 		return false
@@ -58,6 +111,31 @@ func IsValidTranslationTableName(tableName string) bool {
 	return true
 }
 
+// IsValidLanguageTableCode checks that a language code has the two or three letters used in table names.
+func IsValidLanguageTableCode(lang string) bool {
+	return languageCodePattern.MatchString(lang)
+}
+
+// IsValidDataTableName validates that a table name belongs to a language data or translation table.
+func IsValidDataTableName(tableName string) bool {
+	return IsValidTableName(tableName) || IsValidTranslationTableName(tableName)
+}
+
+// IsValidColumnName validates column identifiers before they are quoted into a query.
+func IsValidColumnName(columnName string) bool {
+	if len(columnName) == 0 || len(columnName) > 64 {
+		return false
+	}
+
+	for _, char := range columnName {
+		if !constants.IsAlphaNumeric(char) && char != '_' {
+			return false
+		}
+	}
+
+	return true
+}
+
 // MARK: Pointer Conversion
 
 // ToIntPtr converts various numeric types to a pointer to int.
@@ -89,38 +167,32 @@ func ToStringPtr(v any) *string {
 	}
 }
 
-// MARK: Language Metadata
-
-var languageNames = map[string]string{
-	"EN": "English",
-	"FR": "French",
-	"DE": "German",
-	"ES": "Spanish",
-	"IT": "Italian",
-	"PT": "Portuguese",
-	"RU": "Russian",
-	"SV": "Swedish",
-}
-
-// GetLanguageDisplayName returns the display name for a given language code.
-func GetLanguageDisplayName(code string) string {
-	if name, ok := languageNames[strings.ToUpper(code)]; ok {
-		return name
-	}
-	return strings.ToUpper(code)
-}
-
 // MARK: Response Builders
 
-// BuildLanguageStatResponse constructs a LanguageStatisticsResponse object from raw stat data.
-func BuildLanguageStatResponse(code string, stat map[string]any) models.LanguageStatisticsReponse {
+// BuildLanguageStatResponse constructs a LanguageStatisticsResponse object from raw stat data,
+// with the language's display name given in the language displayIn.
+func BuildLanguageStatResponse(code string, stat map[string]any, displayIn language.Tag) models.LanguageStatisticsReponse {
 	langCode := strings.ToUpper(code)
-	langName := GetLanguageDisplayName(langCode)
+	langName := languages.EnglishName(langCode)
+
+	dataTypes, _ := stat["data_types"].(map[string]int)
+	translations, _ := stat["translations"].(map[string]int)
 
-	return models.LanguageStatisticsReponse{
-		Code:         strings.ToLower(langCode),
-		LanguageName: &langName,
-		Nouns:        ToIntPtr(stat["nouns"].(int)),
-		Verbs:        ToIntPtr(stat["verbs"].(int)),
+	response := models.LanguageStatisticsReponse{
+		Code:             strings.ToLower(langCode),
+		LanguageName:     &langName,
+		DataTypes:        dataTypes,
+		Translations:     translations,
+		LanguageMetadata: languages.Metadata(langCode, displayIn),
 	}
+
+	// Nouns and verbs remain top-level fields for existing clients.
+	if nouns, ok := dataTypes["nouns"]; ok {
+		response.Nouns = ToIntPtr(nouns)
+	}
+	if verbs, ok := dataTypes["verbs"]; ok {
+		response.Verbs = ToIntPtr(verbs)
+	}
+
+	return response
 }
@@ -3,9 +3,11 @@
 package database
 
 import (
+	"database/sql"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 	"golang.org/x/text/cases"
@@ -19,7 +21,7 @@ import (
 func CreateLanguageDataVersionsTable() error {
 	query := `
 		CREATE TABLE IF NOT EXISTS language_data_versions (
-			language_iso VARCHAR(2) PRIMARY KEY,
+			language_iso VARCHAR(3) PRIMARY KEY,
 			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
 			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
@@ -30,10 +32,40 @@ func CreateLanguageDataVersionsTable() error {
 		return fmt.Errorf("error creating language_data_versions table: %w", err)
 	}
 
+	if err := widenLanguageISOColumn(); err != nil {
+		return err
+	}
+
 	log.Println("✅ language_data_versions table ready")
 	return nil
 }
 
+// widenLanguageISOColumn widens the language code column of tables created before three-letter language codes were supported.
+// The column is only altered while it is narrower, as altering rebuilds the table.
+func widenLanguageISOColumn() error {
+	query := `
+		SELECT CHARACTER_MAXIMUM_LENGTH
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = 'language_data_versions' AND COLUMN_NAME = 'language_iso'
+	`
+
+	var length sql.NullInt64
+	err := DB.QueryRow(query, viper.GetString("database.name")).Scan(&length)
+	if err == sql.ErrNoRows || (err == nil && (!length.Valid || length.Int64 >= 3)) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error checking language_data_versions.language_iso: %w", err)
+	}
+
+	if _, err := DB.Exec("ALTER TABLE language_data_versions MODIFY language_iso VARCHAR(3)"); err != nil {
+		return fmt.Errorf("error widening language_data_versions.language_iso: %w", err)
+	}
+
+	log.Println("✅ language_data_versions.language_iso widened to three-letter codes")
+	return nil
+}
+
 // MARK: Version Update
 
 // UpdateLanguageVersion updates the `updated_at` timestamp for a specific language in the `language_data_versions` table.
@@ -105,3 +137,48 @@ func GetLanguageVersions(lang string) (map[string]string, error) {
 
 	return versions, nil
 }
+
+// MARK: Get Migration Time
+
+// GetLanguageMigrationTime returns when the most recently migrated data table of a language was created.
+// The migration recreates tables on every run, so this marks the last time the dataset was replaced.
+func GetLanguageMigrationTime(lang string) (time.Time, error) {
+	query := `
+		SELECT MAX(CREATE_TIME)
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = ?
+		AND TABLE_NAME LIKE ?
+	`
+
+	var createdAt sql.NullTime
+	err := DB.QueryRow(query, viper.GetString("database.name"), strings.ToUpper(lang)+"LanguageData%Scribe").Scan(&createdAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error querying migration time: %w", err)
+	}
+
+	if !createdAt.Valid {
+		return time.Time{}, nil
+	}
+	return createdAt.Time, nil
+}
+
+// GetTableMigrationTime returns when a table was created by the migration, or a zero time if unknown.
+func GetTableMigrationTime(tableName string) (time.Time, error) {
+	query := `
+		SELECT CREATE_TIME
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = ?
+		AND TABLE_NAME = ?
+	`
+
+	var createdAt sql.NullTime
+	err := DB.QueryRow(query, viper.GetString("database.name"), tableName).Scan(&createdAt)
+	if err == sql.ErrNoRows || (err == nil && !createdAt.Valid) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error querying migration time: %w", err)
+	}
+
+	return createdAt.Time, nil
+}
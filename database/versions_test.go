@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package database
+
+import (
+	"strings"
+	"testing"
+)
+
+// MARK: Table Creation
+
+func TestWidenLanguageISOColumn(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   string
+		widened bool
+	}{
+		{"current table", "CREATE TABLE language_data_versions (language_iso VARCHAR(3) PRIMARY KEY)", false},
+		{"wider table", "CREATE TABLE language_data_versions (language_iso VARCHAR(8) PRIMARY KEY)", false},
+		{"missing table", "CREATE TABLE other (language_iso VARCHAR(2))", false},
+		{"table of two-letter codes", "CREATE TABLE language_data_versions (language_iso VARCHAR(2) PRIMARY KEY)", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCatalogDB(t, tt.table)
+
+			// SQLite cannot run the MariaDB ALTER statement, so an attempt to widen the column fails.
+			err := widenLanguageISOColumn()
+			if widened := err != nil && strings.Contains(err.Error(), "error widening"); widened != tt.widened {
+				t.Errorf("widenLanguageISOColumn() = %v, want an attempt to widen: %v", err, tt.widened)
+			}
+			if !tt.widened && err != nil {
+				t.Errorf("widenLanguageISOColumn() = %v", err)
+			}
+		})
+	}
+}
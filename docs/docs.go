@@ -24,15 +24,85 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/contracts": {
+        "/api/v1/complete/{lang}": {
             "get": {
-                "description": "If a 'lang' query parameter is provided, returns the contract for that specific language. Otherwise, returns all contracts.",
+                "description": "Returns noun and verb lemmas and forms of the given language that start with the prefix, ignoring case. Results come from an index built during data migration and are ordered alphabetically.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "Language Data"
+                ],
+                "summary": "Complete a word prefix",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
+                        "name": "lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "ha",
+                        "description": "Beginning of the word to complete",
+                        "name": "prefix",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 10,
+                        "description": "Maximum number of completions (1-100, default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully completed the prefix",
+                        "schema": {
+                            "$ref": "#/definitions/models.CompletionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid language code, prefix or limit",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Language not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error while fetching completions",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/contracts": {
+            "get": {
+                "description": "If a 'lang' query parameter is provided, returns the contract for that specific language. Otherwise, returns all contracts.\nContracts are served from memory and reloaded when their files change. YAML is returned unless another format is negotiated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/x-yaml",
+                    "application/json",
+                    "application/x-ndjson",
+                    "application/msgpack",
+                    "application/cbor"
+                ],
                 "tags": [
                     "Contracts"
                 ],
@@ -41,9 +111,34 @@ const docTemplate = `{
                     {
                         "type": "string",
                         "example": "es",
-                        "description": "Language code (ISO 639-1)",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
                         "name": "lang",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "yaml",
+                            "json",
+                            "ndjson",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -51,22 +146,35 @@ const docTemplate = `{
                         "description": "Successfully retrieved contracts",
                         "schema": {
                             "$ref": "#/definitions/models.ContractsResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the underlying data last changed"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
                     "400": {
-                        "description": "Invalid language code provided",
+                        "description": "Invalid language code or format provided",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Language not supported",
+                        "description": "Language not supported or without contract",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Failed to load contract files",
+                    "406": {
+                        "description": "None of the accepted media types can be served",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -74,50 +182,92 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/data-version/{lang}": {
+        "/api/v1/contracts/{lang}": {
             "get": {
-                "description": "Provides last modified timestamps for each data type of the specified language.",
+                "description": "Returns the contract describing the fields of each data type of the given language.\nContracts are served from memory and reloaded when their files change. YAML is returned unless another format is negotiated.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/x-yaml",
+                    "application/json",
+                    "application/x-ndjson",
+                    "application/msgpack",
+                    "application/cbor"
                 ],
                 "tags": [
-                    "Language Data"
+                    "Contracts"
                 ],
-                "summary": "Get version information for a language",
+                "summary": "Retrieve the schema contract of a language",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "fr",
-                        "description": "Language code (ISO 639-1)",
+                        "example": "de",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
                         "name": "lang",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "yaml",
+                            "json",
+                            "ndjson",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved language version details",
+                        "description": "Successfully retrieved the contract",
                         "schema": {
-                            "$ref": "#/definitions/models.LanguageVersionResponse"
+                            "$ref": "#/definitions/models.LanguageContractResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the contract file last changed"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
                     "400": {
-                        "description": "Invalid language code",
+                        "description": "Invalid language code or format",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Language not found",
+                        "description": "Language not supported or without contract",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "406": {
+                        "description": "None of the accepted media types can be served",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -125,9 +275,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/data/{lang}": {
+        "/api/v1/contracts/{lang}/validate": {
             "get": {
-                "description": "Returns all available language data and schema contract for the given ISO 639-1 language code.",
+                "description": "Reports, per data type, the columns the contract references that the table lacks, the table columns the contract does not mention\nand the contract columns whose database type does not hold text. Data types without a contract section are listed but not compared.",
                 "consumes": [
                     "application/json"
                 ],
@@ -135,14 +285,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Language Data"
+                    "Contracts"
                 ],
-                "summary": "Retrieve full language data",
+                "summary": "Validate a contract against the database",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "en",
-                        "description": "Language code (ISO 639-1)",
+                        "example": "de",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
                         "name": "lang",
                         "in": "path",
                         "required": true
@@ -150,25 +300,25 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved language data",
+                        "description": "Drift between the contract and the tables",
                         "schema": {
-                            "$ref": "#/definitions/models.LanguageDataResponse"
+                            "$ref": "#/definitions/models.ContractValidationResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid or malformed language code",
+                        "description": "Invalid language code",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Requested language not found or unsupported",
+                        "description": "Language not supported or without contract",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error while fetching data",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -176,9 +326,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/language-stats": {
+        "/api/v1/data-version/{lang}": {
             "get": {
-                "description": "Returns the number of nouns and verbs for the specified language codes.",
+                "description": "Provides last modified timestamps for each data type of the specified language.",
                 "consumes": [
                     "application/json"
                 ],
@@ -186,29 +336,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "statistics"
+                    "Language Data"
                 ],
-                "summary": "Get statistics for one or multiple languages",
+                "summary": "Get version information for a language",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Comma-separated list of language codes to filter (e.g., ",
-                        "name": "codes",
-                        "in": "query"
+                        "example": "fr",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
+                        "name": "lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of language statistics",
+                        "description": "Successfully retrieved language version details",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.LanguageStatisticsReponse"
+                            "$ref": "#/definitions/models.LanguageVersionResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the underlying data last changed"
                             }
                         }
                     },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid language code",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -228,28 +402,150 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/languages": {
+        "/api/v1/data/{lang}": {
             "get": {
-                "description": "Fetches all languages currently supported by Scribe, along with their associated data types.",
+                "description": "Returns all available language data and schema contract for the given ISO 639-1 or ISO 639-3 language code.\nRows cannot be filtered here: parameters naming a column are rejected, use /api/v1/data/{lang}/{dataType} instead.\nOther unknown query parameters, such as cache busters, are ignored.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-yaml",
+                    "application/x-ndjson",
+                    "application/msgpack",
+                    "application/cbor"
                 ],
                 "tags": [
-                    "Languages"
+                    "Language Data"
+                ],
+                "summary": "Retrieve full language data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "en",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
+                        "name": "lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum number of rows per data type; enables pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the next_cursor field of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-01-31T12:00:00Z",
+                        "description": "Only return rows modified or migrated after this RFC 3339 timestamp or date, plus the lexeme IDs deleted since",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nouns.singular,nouns.plural,verbs.infinitive",
+                        "description": "Comma-separated data type and field pairs to return; other data types and fields are left out",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ndjson",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "objects",
+                            "columnar"
+                        ],
+                        "type": "string",
+                        "description": "Rows as objects, or as column names followed by lists of values (JSON, YAML, MessagePack and CBOR)",
+                        "name": "layout",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
                 ],
-                "summary": "List all supported languages",
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved available languages",
+                        "description": "Successfully retrieved language data",
                         "schema": {
-                            "$ref": "#/definitions/models.AvailableLanguagesResponse"
+                            "$ref": "#/definitions/models.LanguageDataResponse"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Language code the data is served in, e.g. pt for pt-BR"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the underlying data last changed"
+                            },
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor to the next page in NDJSON and CSV responses"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
+                    "400": {
+                        "description": "Invalid or malformed language code, limit, cursor, since, fields, layout or format, or a row filter",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested language not found or unsupported",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "None of the accepted media types can be served",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pagination requested for data migrated before it was supported",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error occurred while fetching languages",
+                        "description": "Internal server error while fetching data",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -257,68 +553,947 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/translations": {
+        "/api/v1/data/{lang}/{dataType}": {
             "get": {
-                "description": "Returns nested translation data for the given target and source language ISO codes.",
+                "description": "Returns the contract fields and rows of one data type (e.g. nouns, verbs) for the given ISO 639-1 or ISO 639-3 language code.\nRows can be filtered by any column with further query parameters: ` + "`" + `column=value` + "`" + ` (eq), ` + "`" + `column_prefix=value` + "`" + ` (prefix),\n` + "`" + `column_in=a,b` + "`" + ` (in) and ` + "`" + `column_is_null=true|false` + "`" + ` (is_null), e.g. ` + "`" + `?gender=feminine\u0026singular_prefix=Ha` + "`" + `.\nQuery parameters that name no column, such as cache busters, are ignored.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-yaml",
+                    "application/x-ndjson",
+                    "text/csv",
+                    "application/msgpack",
+                    "application/cbor"
                 ],
                 "tags": [
-                    "Translations"
+                    "Language Data"
                 ],
-                "summary": "Retrieve translation data",
+                "summary": "Retrieve data for one data type of a language",
                 "parameters": [
                     {
                         "type": "string",
                         "example": "de",
-                        "description": "Source language code (ISO 639-1)",
-                        "name": "source_lang",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
+                        "name": "lang",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "example": "bn",
-                        "description": "Target language code (ISO 639-1)",
-                        "name": "target_lang",
+                        "example": "verbs",
+                        "description": "Data type as listed by /api/v1/languages",
+                        "name": "dataType",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "maximum": 10000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum number of rows; enables pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the next_cursor field of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-01-31T12:00:00Z",
+                        "description": "Only return rows modified or migrated after this RFC 3339 timestamp or date, plus the lexeme IDs deleted since",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nouns.singular,nouns.plural,verbs.infinitive",
+                        "description": "Comma-separated data type and field pairs to return; other data types and fields are left out",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ndjson",
+                            "csv",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "objects",
+                            "columnar"
+                        ],
+                        "type": "string",
+                        "description": "Rows as objects, or as column names followed by lists of values (JSON, YAML, MessagePack and CBOR)",
+                        "name": "layout",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved translation data",
+                        "description": "Successfully retrieved data type",
                         "schema": {
-                            "$ref": "#/definitions/models.TranslationDataResponse"
+                            "$ref": "#/definitions/models.LanguageDataTypeResponse"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Language code the data is served in, e.g. pt for pt-BR"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the underlying data last changed"
+                            },
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor to the next page in NDJSON and CSV responses"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
                     "400": {
-                        "description": "Invalid language code",
+                        "description": "Invalid or malformed language code, limit, cursor, since, fields, filter, layout or format",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Translation data not found",
+                        "description": "Language or data type not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "406": {
+                        "description": "None of the accepted media types can be served",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
-                    }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "models.AvailableLanguagesResponse": {
+                    },
+                    "409": {
+                        "description": "Pagination requested for data migrated before it was supported",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error while fetching data",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/language-stats": {
+            "get": {
+                "description": "Returns the number of entries of every data type and of translations into each target language for the specified language codes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/x-yaml",
+                    "application/x-ndjson",
+                    "application/msgpack",
+                    "application/cbor"
+                ],
+                "tags": [
+                    "statistics"
+                ],
+                "summary": "Get statistics for one or multiple languages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of language codes to filter (e.g., ",
+                        "name": "codes",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ndjson",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Languages to give display names in (e.g., ",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of language statistics",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.LanguageStatisticsReponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Language not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "None of the accepted media types can be served",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/language-stats/history": {
+            "get": {
+                "description": "Returns the entry counts per data type recorded after each data migration, for charting the growth of the dataset over time.\nLanguages are named in the language requested via Accept-Language.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/x-yaml",
+                    "application/x-ndjson",
+                    "application/msgpack",
+                    "application/cbor"
+                ],
+                "tags": [
+                    "statistics"
+                ],
+                "summary": "Get the statistics history of languages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of language codes to filter (e.g., ",
+                        "name": "codes",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ndjson",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Languages to give display names in (e.g., ",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-01-01",
+                        "description": "Only return snapshots recorded at or after this RFC 3339 timestamp or date",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-12-31T23:59:59Z",
+                        "description": "Only return snapshots recorded at or before this RFC 3339 timestamp or date",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statistics history by language",
+                        "schema": {
+                            "$ref": "#/definitions/models.LanguageStatsHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid language codes, time range or format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "None of the accepted media types can be served",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/language-stats/{lang}/coverage": {
+            "get": {
+                "description": "Returns, for every data type of the language, the share of rows holding a non-null, non-empty value in each column,\nwhether the language contract declares the column, and the average over the declared columns.\nColumns the contract references but the table lacks are marked as missing and count as 0% towards the average.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "statistics"
+                ],
+                "summary": "Get field completeness for a language",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
+                        "name": "lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Field completeness by data type",
+                        "schema": {
+                            "$ref": "#/definitions/models.LanguageCoverageResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the underlying data last changed"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
+                    "400": {
+                        "description": "Invalid language code",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Language not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/languages": {
+            "get": {
+                "description": "Fetches all languages currently supported by Scribe, along with their associated data types,\nEnglish and native names, script, text direction and Wikidata item. Display names follow Accept-Language.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Languages"
+                ],
+                "summary": "List all supported languages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Languages to give display names in (e.g., ",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved available languages",
+                        "schema": {
+                            "$ref": "#/definitions/models.AvailableLanguagesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error occurred while fetching languages",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/lexemes/{lexemeID}": {
+            "get": {
+                "description": "Finds the rows for a Wikidata lexeme ID in every language data and translation table and returns them with their language, data type and contract fields.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Language Data"
+                ],
+                "summary": "Resolve a Wikidata lexeme ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "L9837",
+                        "description": "Wikidata lexeme ID",
+                        "name": "lexemeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully resolved the lexeme ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.LexemeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid lexeme ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Lexeme not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error while searching data",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/lookup/{lang}": {
+            "get": {
+                "description": "Searches all data types of the given language (e.g. nouns, verbs, emoji keywords) for rows where the word is the lemma or one of its forms, and returns them grouped by data type with their contract fields.\nWords are matched ignoring case against the lookup index built by the migration. At most 100 rows are returned per data type; further rows are continued with the next_cursor.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Language Data"
+                ],
+                "summary": "Look up a word in a language",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language code (ISO 639-1 or ISO 639-3) or BCP-47 tag such as pt-BR",
+                        "name": "lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "Haus",
+                        "description": "Lemma or form to look up",
+                        "name": "word",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the next_cursor field of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully looked up the word",
+                        "schema": {
+                            "$ref": "#/definitions/models.LookupResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the underlying data last changed"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
+                    "400": {
+                        "description": "Invalid language code, cursor or missing word",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Language not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error while searching data",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/translations": {
+            "get": {
+                "description": "Returns nested translation data for the given target and source language ISO codes.\nPages hold whole source words. With since set, an empty page is returned unless the data was migrated after it\nor its migration time is unknown.\nWith pivot set, missing language pairs are composed from two tables through an intermediate language and their entries marked as pivoted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/x-yaml",
+                    "application/x-ndjson",
+                    "text/csv",
+                    "application/msgpack",
+                    "application/cbor"
+                ],
+                "tags": [
+                    "Translations"
+                ],
+                "summary": "Retrieve translation data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Source language code (ISO 639-1 or ISO 639-3)",
+                        "name": "source_lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "bn",
+                        "description": "Target language code (ISO 639-1 or ISO 639-3)",
+                        "name": "target_lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "en",
+                        "description": "If no direct translation data exists, compose it through this language, or 'auto' to pick one",
+                        "name": "pivot",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "noun",
+                        "description": "Only return entries of this word type",
+                        "name": "wordType",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum number of source words; enables pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the next_cursor field of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-01-31T12:00:00Z",
+                        "description": "updated_at of a cached copy; data is only returned if it was migrated again since",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ndjson",
+                            "csv",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved translation data",
+                        "schema": {
+                            "$ref": "#/definitions/models.TranslationDataResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the translation data was last migrated"
+                            },
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor to the next page in NDJSON and CSV responses"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
+                    "400": {
+                        "description": "Invalid language code, pivot, word type, limit, cursor, format or since",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Translation data not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "None of the accepted media types can be served",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/translations/pairs": {
+            "get": {
+                "description": "Returns every source and target language pair with translation data, with its number of entries, entries per word type and last migration time.\nThe counts are taken once per migration of the translation tables.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/x-yaml",
+                    "application/x-ndjson",
+                    "application/msgpack",
+                    "application/cbor"
+                ],
+                "tags": [
+                    "Translations"
+                ],
+                "summary": "List translation language pairs",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ndjson",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully listed translation pairs",
+                        "schema": {
+                            "$ref": "#/definitions/models.TranslationPairsResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time translation data was last migrated"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
+                    "400": {
+                        "description": "Invalid format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "None of the accepted media types can be served",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/translations/{source}/{target}/{word}": {
+            "get": {
+                "description": "Returns the translation entries of one source word, nested like /api/v1/translations.\nWith reverse=true the word is matched against the translation column instead, returning the source words that translate to it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/x-yaml",
+                    "application/x-ndjson",
+                    "text/csv",
+                    "application/msgpack",
+                    "application/cbor"
+                ],
+                "tags": [
+                    "Translations"
+                ],
+                "summary": "Translate a single word",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Source language code (ISO 639-1 or ISO 639-3)",
+                        "name": "source",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "en",
+                        "description": "Target language code (ISO 639-1 or ISO 639-3)",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "Haus",
+                        "description": "Source word, or target word in reverse mode",
+                        "name": "word",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Match the word against translations instead of source words",
+                        "name": "reverse",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ndjson",
+                            "csv",
+                            "msgpack",
+                            "cbor"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified time of a cached copy",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully looked up translations",
+                        "schema": {
+                            "$ref": "#/definitions/models.TranslationLookupResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the returned representation"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Time the translation data was last migrated"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is still current"
+                    },
+                    "400": {
+                        "description": "Invalid language code, word, reverse flag or format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Translation data or word not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "None of the accepted media types can be served",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AvailableLanguagesResponse": {
             "type": "object",
             "properties": {
                 "languages": {
@@ -329,6 +1504,81 @@ const docTemplate = `{
                 }
             }
         },
+        "models.ColumnCoverage": {
+            "type": "object",
+            "properties": {
+                "filled": {
+                    "description": "Number of rows with a non-null, non-empty value",
+                    "type": "integer"
+                },
+                "in_contract": {
+                    "description": "Whether the language contract declares the column",
+                    "type": "boolean"
+                },
+                "missing": {
+                    "description": "Whether the contract declares the column but the table lacks it, in which case it is reported at 0%",
+                    "type": "boolean"
+                },
+                "percentage": {
+                    "description": "Share of rows with a value, in percent",
+                    "type": "number"
+                }
+            }
+        },
+        "models.ColumnTypeMismatch": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "description": "Type of the column in the database, e.g. bigint(20)",
+                    "type": "string"
+                },
+                "column": {
+                    "description": "Name of the column",
+                    "type": "string"
+                },
+                "expected": {
+                    "description": "Type the contract requires, e.g. TEXT",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Completion": {
+            "type": "object",
+            "properties": {
+                "data_type": {
+                    "description": "Data type the term was taken from",
+                    "type": "string"
+                },
+                "lexeme_id": {
+                    "description": "Wikidata lexeme ID of the term",
+                    "type": "string"
+                },
+                "term": {
+                    "description": "Lemma or form starting with the requested prefix",
+                    "type": "string"
+                }
+            }
+        },
+        "models.CompletionResponse": {
+            "type": "object",
+            "properties": {
+                "completions": {
+                    "description": "Matching terms in alphabetical order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Completion"
+                    }
+                },
+                "language": {
+                    "description": "ISO code of the language",
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "Prefix that was completed",
+                    "type": "string"
+                }
+            }
+        },
         "models.Contract": {
             "type": "object",
             "properties": {
@@ -352,21 +1602,127 @@ const docTemplate = `{
                 }
             }
         },
-        "models.ContractsResponse": {
+        "models.ContractValidationResponse": {
+            "type": "object",
+            "properties": {
+                "data_types": {
+                    "description": "Differences by data type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.DataTypeDrift"
+                    }
+                },
+                "language": {
+                    "description": "ISO code of the language",
+                    "type": "string"
+                },
+                "valid": {
+                    "description": "Whether the tables match the contract",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ContractsResponse": {
+            "type": "object",
+            "properties": {
+                "contracts": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "models.DataTypeCoverage": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "description": "Completeness by column, excluding the lexeme ID and modification time, including contract columns the table lacks",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.ColumnCoverage"
+                    }
+                },
+                "contract_coverage": {
+                    "description": "Average percentage over the columns declared by the contract (nullable)",
+                    "type": "number"
+                },
+                "rows": {
+                    "description": "Number of rows",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DataTypeDrift": {
+            "type": "object",
+            "properties": {
+                "has_table": {
+                    "description": "Whether the database holds a table for the data type",
+                    "type": "boolean"
+                },
+                "in_contract": {
+                    "description": "Whether the contract describes the data type",
+                    "type": "boolean"
+                },
+                "missing_columns": {
+                    "description": "Columns referenced by the contract that the table lacks",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type_mismatches": {
+                    "description": "Columns referenced by the contract whose database type does not hold text",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ColumnTypeMismatch"
+                    }
+                },
+                "unexpected_columns": {
+                    "description": "Columns of the table that the contract does not mention, excluding the lexeme ID and modification time",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Description of the error",
+                    "type": "string"
+                }
+            }
+        },
+        "models.LanguageContractResponse": {
             "type": "object",
             "properties": {
-                "contracts": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "contract": {
+                    "description": "Contract mapping each data type to the fields it is displayed with"
+                },
+                "language": {
+                    "description": "ISO code of the language",
+                    "type": "string"
                 }
             }
         },
-        "models.ErrorResponse": {
+        "models.LanguageCoverageResponse": {
             "type": "object",
             "properties": {
-                "error": {
-                    "description": "Description of the error",
+                "code": {
+                    "description": "ISO code of the language",
                     "type": "string"
+                },
+                "data_types": {
+                    "description": "Completeness by data type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.DataTypeCoverage"
+                    }
+                },
+                "has_contract": {
+                    "description": "Whether a contract was found to compare the columns against",
+                    "type": "boolean"
                 }
             }
         },
@@ -382,13 +1738,66 @@ const docTemplate = `{
                     ]
                 },
                 "data": {
-                    "description": "Actual data, structured according to the contract",
+                    "description": "Actual data by data type, structured according to the contract, as row objects or in the columnar layout",
                     "type": "object",
                     "additionalProperties": {}
                 },
+                "deleted": {
+                    "description": "Lexeme IDs removed per data type since the requested time, only set for delta requests",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "language": {
+                    "description": "ISO code of the language",
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "description": "Cursor for the next page, omitted when all rows have been served",
+                    "type": "string"
+                }
+            }
+        },
+        "models.LanguageDataTypeResponse": {
+            "type": "object",
+            "properties": {
+                "contract": {
+                    "description": "Contract details defining the schema of the data type",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Contract"
+                        }
+                    ]
+                },
+                "data": {
+                    "description": "Rows of the data type, as objects or in the columnar layout (see ColumnarRows)",
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "data_type": {
+                    "description": "Data type that was requested (e.g. \"nouns\")",
+                    "type": "string"
+                },
+                "deleted": {
+                    "description": "Lexeme IDs removed since the requested time, only set for delta requests",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "language": {
                     "description": "ISO code of the language",
                     "type": "string"
+                },
+                "next_cursor": {
+                    "description": "Cursor for the next page, omitted when all rows have been served",
+                    "type": "string"
                 }
             }
         },
@@ -405,6 +1814,30 @@ const docTemplate = `{
                     "items": {
                         "type": "string"
                     }
+                },
+                "direction": {
+                    "description": "Text direction of the script, \"ltr\" or \"rtl\"",
+                    "type": "string"
+                },
+                "display_name": {
+                    "description": "Name of the language in the language requested via Accept-Language",
+                    "type": "string"
+                },
+                "english_name": {
+                    "description": "Name of the language in English (e.g. \"German\")",
+                    "type": "string"
+                },
+                "native_name": {
+                    "description": "Name of the language in the language itself (e.g. \"Deutsch\")",
+                    "type": "string"
+                },
+                "script": {
+                    "description": "ISO 15924 code of the script the language is written in (e.g. \"Latn\")",
+                    "type": "string"
+                },
+                "wikidata_qid": {
+                    "description": "Wikidata item of the language (e.g. \"Q188\")",
+                    "type": "string"
                 }
             }
         },
@@ -415,17 +1848,107 @@ const docTemplate = `{
                     "description": "ISO code of the language",
                     "type": "string"
                 },
+                "data_types": {
+                    "description": "Count of entries by data type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "direction": {
+                    "description": "Text direction of the script, \"ltr\" or \"rtl\"",
+                    "type": "string"
+                },
+                "display_name": {
+                    "description": "Name of the language in the language requested via Accept-Language",
+                    "type": "string"
+                },
+                "english_name": {
+                    "description": "Name of the language in English (e.g. \"German\")",
+                    "type": "string"
+                },
                 "language_name": {
                     "description": "Human-readable language name (nullable)",
                     "type": "string"
                 },
+                "native_name": {
+                    "description": "Name of the language in the language itself (e.g. \"Deutsch\")",
+                    "type": "string"
+                },
                 "nouns": {
-                    "description": "Count of noun entries",
+                    "description": "Count of noun entries (nullable)",
                     "type": "integer"
                 },
+                "script": {
+                    "description": "ISO 15924 code of the script the language is written in (e.g. \"Latn\")",
+                    "type": "string"
+                },
+                "translations": {
+                    "description": "Count of translation entries from this language by target language",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "verbs": {
-                    "description": "Count of verb entries",
+                    "description": "Count of verb entries (nullable)",
                     "type": "integer"
+                },
+                "wikidata_qid": {
+                    "description": "Wikidata item of the language (e.g. \"Q188\")",
+                    "type": "string"
+                }
+            }
+        },
+        "models.LanguageStatsHistory": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "ISO code of the language",
+                    "type": "string"
+                },
+                "direction": {
+                    "description": "Text direction of the script, \"ltr\" or \"rtl\"",
+                    "type": "string"
+                },
+                "display_name": {
+                    "description": "Name of the language in the language requested via Accept-Language",
+                    "type": "string"
+                },
+                "english_name": {
+                    "description": "Name of the language in English (e.g. \"German\")",
+                    "type": "string"
+                },
+                "native_name": {
+                    "description": "Name of the language in the language itself (e.g. \"Deutsch\")",
+                    "type": "string"
+                },
+                "script": {
+                    "description": "ISO 15924 code of the script the language is written in (e.g. \"Latn\")",
+                    "type": "string"
+                },
+                "snapshots": {
+                    "description": "Snapshots in chronological order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatsSnapshot"
+                    }
+                },
+                "wikidata_qid": {
+                    "description": "Wikidata item of the language (e.g. \"Q188\")",
+                    "type": "string"
+                }
+            }
+        },
+        "models.LanguageStatsHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "languages": {
+                    "description": "History by language, ordered by language code",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LanguageStatsHistory"
+                    }
                 }
             }
         },
@@ -445,6 +1968,106 @@ const docTemplate = `{
                 }
             }
         },
+        "models.LexemeMatch": {
+            "type": "object",
+            "properties": {
+                "data_type": {
+                    "description": "Data type the rows belong to, \"translations\" for translation data",
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "Contract field definitions of the table the rows were found in",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "language": {
+                    "description": "ISO code of the language, the source language for translation data",
+                    "type": "string"
+                },
+                "rows": {
+                    "description": "Matching rows",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": {}
+                    }
+                },
+                "target_language": {
+                    "description": "ISO code of the target language, only set for translation data",
+                    "type": "string"
+                }
+            }
+        },
+        "models.LexemeResponse": {
+            "type": "object",
+            "properties": {
+                "lexeme_id": {
+                    "description": "Wikidata lexeme ID that was looked up",
+                    "type": "string"
+                },
+                "results": {
+                    "description": "Matches by table, ordered by table name",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LexemeMatch"
+                    }
+                }
+            }
+        },
+        "models.LookupResponse": {
+            "type": "object",
+            "properties": {
+                "contract": {
+                    "description": "Contract details defining the schema of each data type with matches",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Contract"
+                        }
+                    ]
+                },
+                "language": {
+                    "description": "ISO code of the language",
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "description": "Cursor to the further matches of data types with more matching rows than returned, omitted if all were returned",
+                    "type": "string"
+                },
+                "results": {
+                    "description": "Matching rows by data type, omitting data types without matches",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "object",
+                            "additionalProperties": {}
+                        }
+                    }
+                },
+                "word": {
+                    "description": "Word that was looked up",
+                    "type": "string"
+                }
+            }
+        },
+        "models.StatsSnapshot": {
+            "type": "object",
+            "properties": {
+                "data_types": {
+                    "description": "Count of entries by data type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "recorded_at": {
+                    "description": "Time the snapshot was recorded (RFC3339 format)",
+                    "type": "string"
+                }
+            }
+        },
         "models.TranslationDataResponse": {
             "type": "object",
             "properties": {
@@ -461,6 +2084,14 @@ const docTemplate = `{
                         }
                     }
                 },
+                "next_cursor": {
+                    "description": "Cursor to request the next page, only set when more words remain",
+                    "type": "string"
+                },
+                "pivot_lang": {
+                    "description": "ISO code of the intermediate language, only set if the data was composed through one",
+                    "type": "string"
+                },
                 "source_lang": {
                     "description": "ISO code of the source language (e.g. \"de\")",
                     "type": "string"
@@ -468,6 +2099,10 @@ const docTemplate = `{
                 "target_lang": {
                     "description": "ISO code of the target language (e.g. \"bn\")",
                     "type": "string"
+                },
+                "updated_at": {
+                    "description": "Time the translation data was last migrated (RFC3339 format), to pass as since on the next sync",
+                    "type": "string"
                 }
             }
         },
@@ -478,9 +2113,91 @@ const docTemplate = `{
                     "description": "Description of the word in the source language",
                     "type": "string"
                 },
+                "pivoted": {
+                    "description": "Whether the translation was composed through an intermediate language",
+                    "type": "boolean"
+                },
                 "translation": {
                     "description": "Translation of the word in the target language",
                     "type": "string"
+                },
+                "via": {
+                    "description": "Word in the intermediate language the translation was composed through",
+                    "type": "string"
+                }
+            }
+        },
+        "models.TranslationLookupResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Nested translation data of the matching source words",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/models.TranslationEntry"
+                            }
+                        }
+                    }
+                },
+                "reverse": {
+                    "description": "Whether the word was matched against translations rather than source words",
+                    "type": "boolean"
+                },
+                "source_lang": {
+                    "description": "ISO code of the source language (e.g. \"de\")",
+                    "type": "string"
+                },
+                "target_lang": {
+                    "description": "ISO code of the target language (e.g. \"bn\")",
+                    "type": "string"
+                },
+                "word": {
+                    "description": "Word that was looked up",
+                    "type": "string"
+                }
+            }
+        },
+        "models.TranslationPair": {
+            "type": "object",
+            "properties": {
+                "last_migrated": {
+                    "description": "Time the translation data was last migrated (RFC3339 format, nullable)",
+                    "type": "string"
+                },
+                "row_count": {
+                    "description": "Number of translation entries",
+                    "type": "integer"
+                },
+                "source_lang": {
+                    "description": "ISO code of the source language",
+                    "type": "string"
+                },
+                "target_lang": {
+                    "description": "ISO code of the target language",
+                    "type": "string"
+                },
+                "word_types": {
+                    "description": "Number of translation entries by word type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "models.TranslationPairsResponse": {
+            "type": "object",
+            "properties": {
+                "pairs": {
+                    "description": "Available language pairs ordered by target and source language",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TranslationPair"
+                    }
                 }
             }
         }
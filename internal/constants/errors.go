@@ -4,8 +4,8 @@
 package constants
 
 const (
-	// InvalidLanguageCodeError indicates that a language code is invalid or unsupported (expects ISO 639-1 format).
-	InvalidLanguageCodeError = "Invalid language code or not supported. Use ISO 639-1 format (e.g., 'en', 'fr')"
+	// InvalidLanguageCodeError indicates that a language code is invalid or unsupported (expects ISO 639-1 or ISO 639-3 format).
+	InvalidLanguageCodeError = "Invalid language code or not supported. Use ISO 639-1 or ISO 639-3 format (e.g., 'en', 'fr', 'dag') or a BCP-47 tag (e.g., 'pt-BR')"
 
 	// ErrorFetchingLanguages indicates a failure when retrieving available languages.
 	ErrorFetchingLanguages = "Failed to fetch available languages"
@@ -13,12 +13,81 @@ const (
 	// ErrorFetchingLanguageVersions indicates a failure when retrieving language version data.
 	ErrorFetchingLanguageVersions = "Failed to fetch language versions"
 
+	// ErrorFetchingLanguageData indicates a failure when retrieving the rows of a language data table.
+	ErrorFetchingLanguageData = "Failed to fetch language data"
+
+	// InvalidLookupWordError indicates that the word to look up is missing or too long.
+	InvalidLookupWordError = "Invalid word. Pass a non-empty word of at most 100 characters (e.g. '?word=Haus')"
+
+	// ErrorSearchingLanguageData indicates a failure when searching the rows of language data tables.
+	ErrorSearchingLanguageData = "Failed to search language data"
+
+	// InvalidFieldsError indicates that the fields parameter of a data request is malformed.
+	InvalidFieldsError = "Invalid fields. Use a comma-separated list of data type and field pairs (e.g. 'nouns.singular,verbs.infinitive')"
+
+	// InvalidHistoryRangeError indicates that the time range of a statistics history request could not be parsed.
+	InvalidHistoryRangeError = "Invalid from or to timestamp. Use RFC 3339 (e.g. '2025-01-31T12:00:00Z') or a date (e.g. '2025-01-31')"
+
+	// InvalidLanguageCodesError indicates that a list of language codes contains a malformed code.
+	InvalidLanguageCodesError = "Invalid language codes. Use a comma-separated list of ISO 639-1 or ISO 639-3 codes (e.g. 'de,fr,dag')"
+
+	// InvalidLexemeIDError indicates that a Wikidata lexeme ID is malformed.
+	InvalidLexemeIDError = "Invalid lexeme ID. Use a Wikidata lexeme ID (e.g. 'L9837')"
+
+	// InvalidCompletionPrefixError indicates that the prefix to complete is missing or too long.
+	InvalidCompletionPrefixError = "Invalid prefix. Pass a prefix of 1-100 characters (e.g. 'ha')"
+
+	// InvalidCompletionLimitError indicates that the requested number of completions is not usable.
+	InvalidCompletionLimitError = "Invalid limit. Use a whole number between 1 and 100"
+
+	// ErrorFetchingCompletions indicates a failure when reading the completion index.
+	ErrorFetchingCompletions = "Failed to fetch completions"
+
 	// InvalidTranslationLangCodeError indicates a translation language code is invalid.
 	InvalidTranslationLangCodeError = "Invalid language code. Use 2-4 lowercase letters (e.g. 'bn', 'de', 'dag')"
 
 	// ErrorFetchingTranslationData indicates a failure when retrieving translation data.
 	ErrorFetchingTranslationData = "Failed to fetch translation data"
 
+	// InvalidPageLimitError indicates that the requested page size is not a usable number.
+	InvalidPageLimitError = "Invalid limit. Use a whole number between 1 and 10000"
+
+	// InvalidCursorError indicates that a pagination cursor could not be decoded.
+	InvalidCursorError = "Invalid cursor. Pass the next_cursor value from a previous response unchanged"
+
+	// PagingUnsupportedError indicates that a data type was migrated before paging was supported and must be migrated again.
+	PagingUnsupportedError = "Pagination is not available for this data until it is migrated again. Request it without limit and cursor"
+
+	// InvalidSinceError indicates that the since timestamp of a delta sync request could not be parsed.
+	InvalidSinceError = "Invalid since timestamp. Use RFC 3339 (e.g. '2025-01-31T12:00:00Z') or a date (e.g. '2025-01-31')"
+
+	// InvalidTranslationWordError indicates that the word of a translation lookup is missing or too long.
+	InvalidTranslationWordError = "Invalid word. Pass a word of 1-100 characters"
+
+	// InvalidReverseError indicates that the reverse flag of a translation lookup is not a boolean.
+	InvalidReverseError = "Invalid reverse flag. Use 'true' or 'false'"
+
+	// InvalidPivotError indicates that the intermediate language of a pivot translation is invalid.
+	InvalidPivotError = "Invalid pivot. Use a language code (e.g. 'en') or 'auto'"
+
+	// InvalidWordTypeError indicates that the word type filter of a translation request is too long.
+	InvalidWordTypeError = "Invalid word type. Pass a word type of at most 100 characters (e.g. 'noun')"
+
+	// FiltersUnsupportedError indicates that row filters were passed to a route serving several data types.
+	FiltersUnsupportedError = "Row filters are only supported for single data types. Request /api/v1/data/{lang}/{dataType} to filter rows"
+
+	// ContractNotFoundError indicates that no contract file exists for a served language.
+	ContractNotFoundError = "No contract found for this language"
+
+	// InvalidLayoutError indicates that the row layout of a data request is unknown.
+	InvalidLayoutError = "Invalid layout. Use 'objects' for one object per row or 'columnar' for column names followed by lists of values"
+
+	// InvalidFormatError indicates that the format parameter names a format the endpoint does not offer.
+	InvalidFormatError = "Invalid format. Use json, yaml, ndjson, msgpack or cbor, or csv for single data types and translations"
+
+	// NotAcceptableError indicates that the Accept header allows none of the formats the endpoint offers.
+	NotAcceptableError = "None of the accepted media types can be served. Accept e.g. application/json or pass the format parameter"
+
 	// EmptyTranslationCodeError indicates a failure when language code is not passed.
 	EmptyTranslationCodeError = "Empty translation code detected. Ensure you pass in valid source and target language code"
 )
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package constants
+
+const (
+	// DefaultPageLimit is the number of rows per data type returned when only a cursor is given.
+	DefaultPageLimit = 1000
+	// MaxPageLimit is the largest number of rows per data type a single page may request.
+	MaxPageLimit = 10000
+	// MaxCursorLength is the longest pagination cursor, in bytes, that is decoded; longer ones are rejected unread.
+	MaxCursorLength = 8192
+	// MaxLookupRows is the largest number of matching rows per data type returned by a word lookup.
+	MaxLookupRows = 100
+	// MaxLookupWordLength is the longest word, in characters, that can be looked up.
+	MaxLookupWordLength = 100
+	// MaxFilterValues is the largest number of values an in filter may list.
+	MaxFilterValues = 100
+	// DefaultCompletionLimit is the number of completions returned when no limit is given.
+	DefaultCompletionLimit = 10
+	// MaxCompletionLimit is the largest number of completions a single request may ask for.
+	MaxCompletionLimit = 100
+)
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package contracts
+
+import (
+	"log"
+	"maps"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDelay collects the bursts of file events an export causes into a single reload.
+const reloadDelay = 250 * time.Millisecond
+
+// MARK: Cache
+
+// Cache holds the parsed contracts of a directory in memory and reloads them when its files change.
+// It is safe for concurrent use. The zero Cache holds no contracts.
+type Cache struct {
+	dir string
+
+	mu        sync.RWMutex
+	contracts map[string]*Contract
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+// NewCache loads the contracts in dir into a new cache.
+// A missing or unreadable directory is logged and leaves the cache empty until it can be reloaded.
+func NewCache(dir string) *Cache {
+	c := &Cache{
+		dir:       dir,
+		contracts: make(map[string]*Contract),
+	}
+	if err := c.Reload(); err != nil {
+		log.Printf("⚠️ Could not load contracts: %v", err)
+	}
+	return c
+}
+
+// Dir returns the directory the contracts are loaded from.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// Get returns the contract of a language, or false if it has none.
+func (c *Cache) Get(lang string) (*Contract, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	contract, ok := c.contracts[lang]
+	return contract, ok
+}
+
+// All returns the contracts of all languages, keyed by language code.
+func (c *Cache) All() map[string]*Contract {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return maps.Clone(c.contracts)
+}
+
+// Reload reads the contracts directory again and replaces the cached contracts.
+// The cache is emptied if the directory cannot be read.
+func (c *Cache) Reload() error {
+	contracts, err := LoadDir(c.dir)
+	if err != nil {
+		contracts = make(map[string]*Contract)
+	}
+
+	c.mu.Lock()
+	c.contracts = contracts
+	c.mu.Unlock()
+
+	return err
+}
+
+// MARK: Hot Reload
+
+// Watch reloads the cache whenever a contract file is created, changed or removed.
+// The parent directory is watched as well, so that the contracts directory can be deleted and exported anew.
+func (c *Cache) Watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	if err := watcher.Add(filepath.Dir(filepath.Clean(c.dir))); err != nil {
+		watcher.Close()
+		return err
+	}
+	// The directory itself may not exist yet, in which case it is added once it is created.
+	_ = watcher.Add(c.dir)
+
+	c.watcher = watcher
+	c.done = make(chan struct{})
+	go c.watch()
+
+	return nil
+}
+
+// Close stops watching the contracts directory.
+func (c *Cache) Close() error {
+	if c.watcher == nil {
+		return nil
+	}
+	close(c.done)
+	return c.watcher.Close()
+}
+
+// watch handles file events until the cache is closed, reloading once events have settled.
+func (c *Cache) watch() {
+	dir := filepath.Clean(c.dir)
+	timer := time.NewTimer(reloadDelay)
+	timer.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			timer.Stop()
+			return
+
+		case event, ok := <-c.watcher.Events:
+			if !ok {
+				return
+			}
+
+			path := filepath.Clean(event.Name)
+			switch {
+			case path == dir:
+				if event.Has(fsnotify.Create) {
+					if err := c.watcher.Add(dir); err != nil {
+						log.Printf("⚠️ Could not watch contracts directory: %v", err)
+					}
+				}
+			case filepath.Dir(path) != dir || !isContractFile(path):
+				continue
+			}
+			timer.Reset(reloadDelay)
+
+		case err, ok := <-c.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("⚠️ Error watching contracts directory: %v", err)
+
+		case <-timer.C:
+			if err := c.Reload(); err != nil {
+				log.Printf("⚠️ Could not reload contracts: %v", err)
+				continue
+			}
+			log.Printf("📜 Reloaded %d contracts from %s", len(c.All()), c.dir)
+		}
+	}
+}
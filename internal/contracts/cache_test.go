@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package contracts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeContract writes the contract file of a language into dir.
+func writeContract(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// contractDataTypes returns the top-level sections of a cached contract, or nil if the cache holds none for lang.
+func contractDataTypes(cache *Cache, lang string) map[string]any {
+	contract, ok := cache.Get(lang)
+	if !ok {
+		return nil
+	}
+	sections, _ := contract.Content.(map[string]any)
+	return sections
+}
+
+// MARK: Loading
+
+func TestNewCache(t *testing.T) {
+	dir := t.TempDir()
+	writeContract(t, dir, "de.yaml", "nouns:\n  numbers:\n    singular: plural\n")
+	writeContract(t, dir, "de.yml", "verbs:\n  infinitive: infinitive\n")
+	writeContract(t, dir, "sv.yml", "verbs:\n  infinitive: infinitive\n")
+	writeContract(t, dir, "fr.yaml", "nouns: [unclosed\n")
+	writeContract(t, dir, "README.md", "# Contracts\n")
+
+	cache := NewCache(dir)
+
+	all := cache.All()
+	if len(all) != 2 {
+		t.Fatalf("All() = %v, want the contracts of de and sv", all)
+	}
+	// The .yaml file of a language takes precedence over its .yml file.
+	if sections := contractDataTypes(cache, "de"); sections["nouns"] == nil || sections["verbs"] != nil {
+		t.Errorf("contract of de = %v, want the nouns of de.yaml", sections)
+	}
+	if sections := contractDataTypes(cache, "sv"); sections["verbs"] == nil {
+		t.Errorf("contract of sv = %v, want the verbs of sv.yml", sections)
+	}
+	if _, ok := cache.Get("fr"); ok {
+		t.Error("Get(fr) found a contract that cannot be parsed")
+	}
+
+	contract, _ := cache.Get("de")
+	if contract.Language != "de" || len(contract.Digest) != 64 || contract.ModTime.IsZero() {
+		t.Errorf("contract of de = %q, digest %q, modified %v", contract.Language, contract.Digest, contract.ModTime)
+	}
+
+	// All returns a copy that callers may change.
+	delete(all, "de")
+	if _, ok := cache.Get("de"); !ok {
+		t.Error("deleting from All() removed the contract from the cache")
+	}
+}
+
+func TestNewCacheWithoutDirectory(t *testing.T) {
+	cache := NewCache(filepath.Join(t.TempDir(), "missing"))
+	if all := cache.All(); len(all) != 0 {
+		t.Errorf("All() = %v, want no contracts", all)
+	}
+
+	var zero Cache
+	if _, ok := zero.Get("de"); ok || len(zero.All()) != 0 {
+		t.Error("the zero Cache holds contracts")
+	}
+}
+
+func TestCacheReload(t *testing.T) {
+	dir := t.TempDir()
+	writeContract(t, dir, "de.yaml", "nouns:\n  numbers:\n    singular: plural\n")
+
+	cache := NewCache(dir)
+	before, _ := cache.Get("de")
+
+	writeContract(t, dir, "de.yaml", "verbs:\n  infinitive: infinitive\n")
+	writeContract(t, dir, "sv.yaml", "verbs:\n  infinitive: infinitive\n")
+	if err := cache.Reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	after, _ := cache.Get("de")
+	if after.Digest == before.Digest || contractDataTypes(cache, "de")["verbs"] == nil {
+		t.Errorf("contract of de = %v after reload, want the changed file", after.Content)
+	}
+	if _, ok := cache.Get("sv"); !ok {
+		t.Error("Get(sv) found no contract after reload")
+	}
+
+	// A directory that can no longer be read empties the cache.
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Reload(); err == nil {
+		t.Error("Reload() of a removed directory returned no error")
+	}
+	if all := cache.All(); len(all) != 0 {
+		t.Errorf("All() = %v after the directory was removed, want no contracts", all)
+	}
+}
+
+// MARK: Hot Reload
+
+func TestCacheWatch(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "contracts")
+	cache := NewCache(dir)
+	if err := cache.Watch(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cache.Close() })
+
+	// waitFor polls the cache until it matches or the reload is overdue.
+	waitFor := func(what string, matches func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for !matches() {
+			if time.Now().After(deadline) {
+				t.Fatalf("cache was not reloaded: %s", what)
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+	}
+
+	// The directory is picked up once it is created.
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * reloadDelay)
+	writeContract(t, dir, "de.yaml", "nouns:\n  numbers:\n    singular: plural\n")
+	waitFor("de.yaml created", func() bool { return contractDataTypes(cache, "de")["nouns"] != nil })
+
+	writeContract(t, dir, "de.yaml", "verbs:\n  infinitive: infinitive\n")
+	waitFor("de.yaml changed", func() bool { return contractDataTypes(cache, "de")["verbs"] != nil })
+
+	if err := os.Remove(filepath.Join(dir, "de.yaml")); err != nil {
+		t.Fatal(err)
+	}
+	waitFor("de.yaml removed", func() bool { _, ok := cache.Get("de"); return !ok })
+}
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package contracts loads the data contracts exported from Scribe-Data, which describe the fields of each data type of a language.
+package contracts
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/scribe-org/scribe-server/internal/constants"
+	"gopkg.in/yaml.v3"
+)
+
+// Contract is the parsed contract file of a single language.
+type Contract struct {
+	// Language code taken from the file name (e.g. "de")
+	Language string
+	// Parsed YAML content, with data types as top-level keys
+	Content any
+	// SHA-256 of the file content, used to derive entity tags
+	Digest string
+	// Modification time of the file
+	ModTime time.Time
+}
+
+// MARK: Loading
+
+// isContractFile reports whether a file name has one of the extensions contracts are exported with.
+func isContractFile(name string) bool {
+	ext := filepath.Ext(name)
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// FilePath returns the path of a language's contract, preferring the .yaml extension.
+func FilePath(dir, lang string) string {
+	filePathYaml := filepath.Join(dir, lang+".yaml")
+	filePathYml := filepath.Join(dir, lang+".yml")
+
+	if _, err := os.Stat(filePathYaml); os.IsNotExist(err) {
+		return filePathYml
+	}
+	return filePathYaml
+}
+
+// LoadFile reads and parses the contract file at path for the given language.
+func LoadFile(path, lang string) (*Contract, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not stat contract file for %s: %w", lang, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read contract file for %s: %w", lang, err)
+	}
+
+	var content any
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("could not unmarshal contract for %s: %w", lang, err)
+	}
+
+	digest := sha256.Sum256(data)
+
+	return &Contract{
+		Language: lang,
+		Content:  normalizeMap(content),
+		Digest:   hex.EncodeToString(digest[:]),
+		ModTime:  info.ModTime(),
+	}, nil
+}
+
+// LoadDir reads and parses all contract files in a directory, keyed by language code.
+// Files that cannot be read or parsed are skipped with a warning.
+func LoadDir(dir string) (map[string]*Contract, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read contracts directory: %w", err)
+	}
+
+	contracts := make(map[string]*Contract)
+	for _, file := range files {
+		if file.IsDir() || !isContractFile(file.Name()) {
+			continue
+		}
+
+		lang := strings.TrimSuffix(file.Name(), filepath.Ext(file.Name()))
+		if _, ok := contracts[lang]; ok && filepath.Ext(file.Name()) == ".yml" {
+			// The .yaml file of a language takes precedence, as in FilePath.
+			continue
+		}
+
+		contract, err := LoadFile(filepath.Join(dir, file.Name()), lang)
+		if err != nil {
+			log.Printf("Warning: %v", err)
+			continue
+		}
+		contracts[lang] = contract
+	}
+
+	return contracts, nil
+}
+
+// MARK: Fields
+
+// displayKeys are the contract keys whose values are text shown to users rather than column references.
+var displayKeys = map[string]bool{"title": true, "label": true, "displayValue": true}
+
+// DataTypeKey normalizes a contract section name onto the data type of its table, e.g. emoji_keywords -> emojikeywords.
+func DataTypeKey(section string) string {
+	return strings.ToLower(strings.ReplaceAll(section, "_", ""))
+}
+
+// Fields returns the field names the contract references for each of its data types, keyed by data type.
+// Contracts map display labels and grammatical categories to column names, so any identifier may be a field.
+func (c *Contract) Fields() map[string]map[string]bool {
+	sections, _ := c.Content.(map[string]any)
+	fields := make(map[string]map[string]bool, len(sections))
+	for dataType, section := range sections {
+		names := make(map[string]bool)
+		collectNames(section, names)
+		fields[DataTypeKey(dataType)] = names
+	}
+	return fields
+}
+
+// Columns returns the column names the contract definitely references for each of its data types, keyed by data type.
+// Unlike Fields it leaves out display labels, titles and placeholders such as NOT_INCLUDED, so every name is expected in the table.
+func (c *Contract) Columns() map[string]map[string]bool {
+	sections, _ := c.Content.(map[string]any)
+	columns := make(map[string]map[string]bool, len(sections))
+	for dataType, section := range sections {
+		names := make(map[string]bool)
+		collectColumns(section, names)
+		columns[DataTypeKey(dataType)] = names
+	}
+	return columns
+}
+
+// collectNames adds every identifier used as a key or value within a contract section to names.
+func collectNames(value any, names map[string]bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			addNames(key, names)
+			collectNames(item, names)
+		}
+	case []any:
+		for _, item := range v {
+			collectNames(item, names)
+		}
+	case string:
+		addNames(v, names)
+	}
+}
+
+// collectColumns adds the column names referenced by the values within a contract section to names.
+// Keys are display labels, except within the numbers mapping of nouns, which pairs singular with plural columns.
+func collectColumns(value any, names map[string]bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if displayKeys[key] {
+				continue
+			}
+			if key == "numbers" {
+				if numbers, ok := item.(map[string]any); ok {
+					for singular := range numbers {
+						addColumns(singular, names)
+					}
+				}
+			}
+			collectColumns(item, names)
+		}
+	case []any:
+		for _, item := range v {
+			collectColumns(item, names)
+		}
+	case string:
+		addColumns(v, names)
+	}
+}
+
+// addColumns adds the identifiers in text that are named like columns, starting with a lowercase letter, to names.
+func addColumns(text string, names map[string]bool) {
+	found := make(map[string]bool)
+	addNames(text, found)
+	for name := range found {
+		if first, _ := utf8.DecodeRuneInString(name); unicode.IsLower(first) {
+			names[name] = true
+		}
+	}
+}
+
+// addNames splits text into identifiers and adds them to names.
+func addNames(text string, names map[string]bool) {
+	for _, name := range strings.FieldsFunc(text, func(r rune) bool {
+		return !constants.IsAlphaNumeric(r) && r != '_'
+	}) {
+		names[name] = true
+	}
+}
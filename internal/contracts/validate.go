@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package contracts
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// expectedColumnType is the database type of the columns contracts reference, as the migration creates them from text fields.
+const expectedColumnType = "TEXT"
+
+// MARK: Schema Drift
+
+// Validate compares a contract with the tables of its language and reports the drift per data type.
+// Data types without a contract section are listed but not compared, as contracts need not describe every table.
+func Validate(contract *Contract) (models.ContractValidationResponse, error) {
+	dataTypes, err := database.GetLanguageDataTypes(contract.Language)
+	if err != nil {
+		return models.ContractValidationResponse{}, err
+	}
+
+	columns := contract.Columns()
+	fields := contract.Fields()
+
+	response := models.ContractValidationResponse{
+		Language:  contract.Language,
+		Valid:     true,
+		DataTypes: make(map[string]models.DataTypeDrift, len(dataTypes)),
+	}
+
+	for _, dataType := range dataTypes {
+		response.DataTypes[dataType] = models.DataTypeDrift{HasTable: true}
+	}
+
+	for dataType, referenced := range columns {
+		drift := response.DataTypes[dataType]
+		drift.InContract = true
+
+		var schema map[string]string
+		if drift.HasTable {
+			schema, err = database.GetTableSchema(database.LanguageTableName(contract.Language, dataType))
+			if err != nil {
+				return models.ContractValidationResponse{}, err
+			}
+		}
+
+		compareSchema(&drift, schema, referenced, fields[dataType])
+		if !drift.HasTable || len(drift.MissingColumns) > 0 || len(drift.UnexpectedColumns) > 0 || len(drift.TypeMismatches) > 0 {
+			response.Valid = false
+		}
+		response.DataTypes[dataType] = drift
+	}
+
+	return response, nil
+}
+
+// compareSchema records the columns a contract section references that are absent from or mistyped in a table schema,
+// along with the columns of the schema the section does not mention.
+func compareSchema(drift *models.DataTypeDrift, schema map[string]string, referenced, mentioned map[string]bool) {
+	for _, column := range slices.Sorted(maps.Keys(referenced)) {
+		columnType, ok := schema[column]
+		switch {
+		case !ok:
+			drift.MissingColumns = append(drift.MissingColumns, column)
+		case !database.IsTextColumnType(columnType):
+			drift.TypeMismatches = append(drift.TypeMismatches, models.ColumnTypeMismatch{
+				Column:   column,
+				Expected: expectedColumnType,
+				Actual:   columnType,
+			})
+		}
+	}
+
+	for _, column := range slices.Sorted(maps.Keys(schema)) {
+		isMeta := column == database.LexemeIDColumn || column == database.LastModifiedColumn
+		if !isMeta && !mentioned[column] {
+			drift.UnexpectedColumns = append(drift.UnexpectedColumns, column)
+		}
+	}
+}
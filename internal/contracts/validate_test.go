@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package contracts
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/scribe-org/scribe-server/database"
+	"github.com/scribe-org/scribe-server/internal/testdb"
+	"github.com/scribe-org/scribe-server/models"
+)
+
+// driftContract references a mistyped, a missing and an untabled column besides the columns of its tables.
+const driftContract = `nouns:
+  displayValue: singular
+  numbers:
+    singular: plural
+  genders:
+    title: Gender
+    masculine: gender
+verbs:
+  conjugations:
+    1:
+      title: Präsens
+      tenses:
+        1:
+          title: Perfekt
+          tenseForms:
+            ich: infinitive
+            du: pastParticiple
+emoji_keywords:
+  emojiKeywords: emoji
+`
+
+// parseContract parses the content of a contract file for a language.
+func parseContract(t *testing.T, lang, content string) *Contract {
+	t.Helper()
+
+	dir := t.TempDir()
+	writeContract(t, dir, lang+".yaml", content)
+	contract, err := LoadFile(FilePath(dir, lang), lang)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return contract
+}
+
+// MARK: Columns
+
+func TestContractColumns(t *testing.T) {
+	contract := parseContract(t, "de", driftContract)
+
+	want := map[string]map[string]bool{
+		"nouns":         {"singular": true, "plural": true, "gender": true},
+		"verbs":         {"infinitive": true, "pastParticiple": true},
+		"emojikeywords": {"emoji": true},
+	}
+	if got := contract.Columns(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Columns() = %v, want %v", got, want)
+	}
+
+	// Fields also holds display labels, which requests may name.
+	fields := contract.Fields()
+	if !fields["nouns"]["masculine"] || !fields["verbs"]["ich"] || fields["nouns"]["infinitive"] {
+		t.Errorf("Fields() = %v, want the keys and values of each section", fields)
+	}
+}
+
+// MARK: Schema Drift
+
+func TestValidate(t *testing.T) {
+	previous := database.DB
+	database.DB = testdb.Open(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID VARCHAR(64), singular TEXT, plural VARCHAR(255), gender INTEGER, count INTEGER, lastModified DATETIME)",
+		"CREATE TABLE DELanguageDataVerbsScribe (lexemeID VARCHAR(64), infinitive TEXT)",
+		"CREATE TABLE DELanguageDataAdjectivesScribe (lexemeID VARCHAR(64), positive TEXT)",
+	)
+	t.Cleanup(func() { database.DB = previous })
+
+	got, err := Validate(parseContract(t, "de", driftContract))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := models.ContractValidationResponse{
+		Language: "de",
+		Valid:    false,
+		DataTypes: map[string]models.DataTypeDrift{
+			"nouns": {
+				InContract:        true,
+				HasTable:          true,
+				UnexpectedColumns: []string{"count"},
+				TypeMismatches:    []models.ColumnTypeMismatch{{Column: "gender", Expected: "TEXT", Actual: "integer"}},
+			},
+			"verbs":         {InContract: true, HasTable: true, MissingColumns: []string{"pastParticiple"}},
+			"emojikeywords": {InContract: true, MissingColumns: []string{"emoji"}},
+			// Tables the contract does not describe are listed but not compared.
+			"adjectives": {HasTable: true},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %+v, want %+v", got, want)
+	}
+}
+
+func TestValidateMatchingContract(t *testing.T) {
+	previous := database.DB
+	database.DB = testdb.Open(t,
+		"CREATE TABLE DELanguageDataNounsScribe (lexemeID VARCHAR(64), singular TEXT, plural TEXT)",
+		"CREATE TABLE DELanguageDataAdjectivesScribe (lexemeID VARCHAR(64), positive TEXT)",
+	)
+	t.Cleanup(func() { database.DB = previous })
+
+	got, err := Validate(parseContract(t, "de", "nouns:\n  numbers:\n    singular: plural\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Valid {
+		t.Errorf("Validate() = %+v, want a valid contract", got)
+	}
+}
@@ -1,6 +1,6 @@
 // SPDX-License-Identifier: GPL-3.0-or-later
 
-package handlers
+package contracts
 
 import "fmt"
 
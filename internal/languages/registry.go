@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package languages provides names, scripts and identifiers of the languages Scribe serves.
+package languages
+
+import (
+	"strings"
+
+	"github.com/scribe-org/scribe-server/models"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// Text directions of a script.
+const (
+	DirectionLTR = "ltr"
+	DirectionRTL = "rtl"
+)
+
+// entry holds the registered metadata of a language.
+type entry struct {
+	englishName string
+	nativeName  string
+	script      string
+	wikidataQID string
+}
+
+// registry maps lowercase ISO 639 codes to the metadata of the languages Scribe serves.
+// Languages missing here fall back to the names and likely script known to golang.org/x/text.
+var registry = map[string]entry{
+	"ar":  {"Arabic", "العربية", "Arab", "Q13955"},
+	"bn":  {"Bengali", "বাংলা", "Beng", "Q9610"},
+	"cs":  {"Czech", "čeština", "Latn", "Q9056"},
+	"da":  {"Danish", "dansk", "Latn", "Q9035"},
+	"dag": {"Dagbani", "Dagbanli", "Latn", "Q32238"},
+	"de":  {"German", "Deutsch", "Latn", "Q188"},
+	"el":  {"Greek", "Ελληνικά", "Grek", "Q36510"},
+	"en":  {"English", "English", "Latn", "Q1860"},
+	"eo":  {"Esperanto", "Esperanto", "Latn", "Q143"},
+	"es":  {"Spanish", "español", "Latn", "Q1321"},
+	"et":  {"Estonian", "eesti", "Latn", "Q9072"},
+	"fa":  {"Persian", "فارسی", "Arab", "Q9168"},
+	"fi":  {"Finnish", "suomi", "Latn", "Q1412"},
+	"fr":  {"French", "français", "Latn", "Q150"},
+	"ha":  {"Hausa", "Hausa", "Latn", "Q56475"},
+	"he":  {"Hebrew", "עברית", "Hebr", "Q9288"},
+	"hi":  {"Hindi", "हिन्दी", "Deva", "Q1568"},
+	"id":  {"Indonesian", "Bahasa Indonesia", "Latn", "Q9240"},
+	"ig":  {"Igbo", "Igbo", "Latn", "Q33578"},
+	"it":  {"Italian", "italiano", "Latn", "Q652"},
+	"ja":  {"Japanese", "日本語", "Jpan", "Q5287"},
+	"kmr": {"Kurmanji", "Kurmancî", "Latn", "Q36163"},
+	"ko":  {"Korean", "한국어", "Kore", "Q9176"},
+	"la":  {"Latin", "Latina", "Latn", "Q397"},
+	"ml":  {"Malayalam", "മലയാളം", "Mlym", "Q36236"},
+	"ms":  {"Malay", "Bahasa Melayu", "Latn", "Q9237"},
+	"nb":  {"Norwegian Bokmål", "norsk bokmål", "Latn", "Q25167"},
+	"nl":  {"Dutch", "Nederlands", "Latn", "Q7411"},
+	"nn":  {"Norwegian Nynorsk", "norsk nynorsk", "Latn", "Q25164"},
+	"pa":  {"Punjabi", "ਪੰਜਾਬੀ", "Guru", "Q58635"},
+	"pcm": {"Nigerian Pidgin", "Naijá", "Latn", "Q33655"},
+	"pl":  {"Polish", "polski", "Latn", "Q809"},
+	"pt":  {"Portuguese", "português", "Latn", "Q5146"},
+	"ru":  {"Russian", "русский", "Cyrl", "Q7737"},
+	"sk":  {"Slovak", "slovenčina", "Latn", "Q9058"},
+	"sv":  {"Swedish", "svenska", "Latn", "Q9027"},
+	"sw":  {"Swahili", "Kiswahili", "Latn", "Q7838"},
+	"ta":  {"Tamil", "தமிழ்", "Taml", "Q5885"},
+	"tg":  {"Tajik", "тоҷикӣ", "Cyrl", "Q9260"},
+	"tr":  {"Turkish", "Türkçe", "Latn", "Q256"},
+	"uk":  {"Ukrainian", "українська", "Cyrl", "Q8798"},
+	"ur":  {"Urdu", "اردو", "Arab", "Q1617"},
+	"yo":  {"Yoruba", "Èdè Yorùbá", "Latn", "Q34311"},
+	"zh":  {"Chinese", "中文", "Hans", "Q7850"},
+}
+
+// rtlScripts lists the ISO 15924 codes of scripts written from right to left.
+var rtlScripts = map[string]bool{
+	"Adlm": true,
+	"Arab": true,
+	"Hebr": true,
+	"Mand": true,
+	"Nkoo": true,
+	"Rohg": true,
+	"Samr": true,
+	"Syrc": true,
+	"Thaa": true,
+}
+
+// displayMatcher matches Accept-Language preferences against the languages names can be displayed in.
+var displayMatcher = language.NewMatcher(display.Supported.Tags())
+
+// MARK: Lookup
+
+// lookup returns the registered metadata of a language, completed from golang.org/x/text for unregistered codes.
+func lookup(code string) entry {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if e, ok := registry[code]; ok {
+		return e
+	}
+
+	e := entry{englishName: strings.ToUpper(code)}
+	tag, err := language.Parse(code)
+	if err != nil {
+		return e
+	}
+
+	if name := display.English.Languages().Name(tag); name != "" {
+		e.englishName = name
+	}
+	e.nativeName = display.Self.Name(tag)
+	if script, confidence := tag.Script(); confidence != language.No {
+		e.script = script.String()
+	}
+
+	return e
+}
+
+// EnglishName returns the English name of a language, or its uppercase code if the name is unknown.
+func EnglishName(code string) string {
+	return lookup(code).englishName
+}
+
+// Direction returns the text direction of a script, "rtl" or "ltr".
+func Direction(script string) string {
+	if rtlScripts[script] {
+		return DirectionRTL
+	}
+	return DirectionLTR
+}
+
+// Metadata returns the names, script, text direction and Wikidata QID of a language.
+// The display name is given in the language displayIn, falling back to the autonym or English name.
+func Metadata(code string, displayIn language.Tag) models.LanguageMetadata {
+	e := lookup(code)
+
+	metadata := models.LanguageMetadata{
+		EnglishName: e.englishName,
+		NativeName:  e.nativeName,
+		DisplayName: e.englishName,
+		Script:      e.script,
+		Direction:   Direction(e.script),
+		WikidataQID: e.wikidataQID,
+	}
+	if metadata.NativeName == "" {
+		metadata.NativeName = e.englishName
+	}
+
+	tag, err := language.Parse(code)
+	if err != nil {
+		return metadata
+	}
+
+	// A language is displayed by its autonym to readers of that language.
+	displayBase, _ := displayIn.Base()
+	codeBase, _ := tag.Base()
+	if displayBase == codeBase {
+		metadata.DisplayName = metadata.NativeName
+	} else if name := display.Languages(displayIn).Name(tag); name != "" {
+		metadata.DisplayName = name
+	}
+
+	return metadata
+}
+
+// MARK: Display Language
+
+// DisplayLanguage picks the language to display names in from an Accept-Language header.
+// It falls back to English when the header is empty, malformed or matches no supported language.
+func DisplayLanguage(acceptLanguage string) language.Tag {
+	if strings.TrimSpace(acceptLanguage) == "" {
+		return language.English
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return language.English
+	}
+
+	tag, _, confidence := displayMatcher.Match(tags...)
+	if confidence == language.No {
+		return language.English
+	}
+	return tag
+}
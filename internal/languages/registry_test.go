@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package languages
+
+import (
+	"testing"
+
+	"github.com/scribe-org/scribe-server/models"
+	"golang.org/x/text/language"
+)
+
+// MARK: Metadata
+
+func TestMetadata(t *testing.T) {
+	tests := []struct {
+		code      string
+		displayIn language.Tag
+		want      models.LanguageMetadata
+	}{
+		{
+			code:      "de",
+			displayIn: language.English,
+			want:      models.LanguageMetadata{EnglishName: "German", NativeName: "Deutsch", DisplayName: "German", Script: "Latn", Direction: DirectionLTR, WikidataQID: "Q188"},
+		},
+		{
+			// Readers of a language see its autonym.
+			code:      "de",
+			displayIn: language.MustParse("de-CH"),
+			want:      models.LanguageMetadata{EnglishName: "German", NativeName: "Deutsch", DisplayName: "Deutsch", Script: "Latn", Direction: DirectionLTR, WikidataQID: "Q188"},
+		},
+		{
+			code:      "sv",
+			displayIn: language.German,
+			want:      models.LanguageMetadata{EnglishName: "Swedish", NativeName: "svenska", DisplayName: "Schwedisch", Script: "Latn", Direction: DirectionLTR, WikidataQID: "Q9027"},
+		},
+		{
+			code:      "ar",
+			displayIn: language.English,
+			want:      models.LanguageMetadata{EnglishName: "Arabic", NativeName: "العربية", DisplayName: "Arabic", Script: "Arab", Direction: DirectionRTL, WikidataQID: "Q13955"},
+		},
+		{
+			code:      "HE",
+			displayIn: language.English,
+			want:      models.LanguageMetadata{EnglishName: "Hebrew", NativeName: "עברית", DisplayName: "Hebrew", Script: "Hebr", Direction: DirectionRTL, WikidataQID: "Q9288"},
+		},
+		{
+			// Unregistered languages fall back to golang.org/x/text.
+			code:      "cy",
+			displayIn: language.English,
+			want:      models.LanguageMetadata{EnglishName: "Welsh", NativeName: "Cymraeg", DisplayName: "Welsh", Script: "Latn", Direction: DirectionLTR},
+		},
+		{
+			code:      "not a tag",
+			displayIn: language.English,
+			want:      models.LanguageMetadata{EnglishName: "NOT A TAG", NativeName: "NOT A TAG", DisplayName: "NOT A TAG", Direction: DirectionLTR},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := Metadata(tt.code, tt.displayIn); got != tt.want {
+			t.Errorf("Metadata(%q, %v) = %+v, want %+v", tt.code, tt.displayIn, got, tt.want)
+		}
+	}
+}
+
+func TestEnglishName(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"de", "German"},
+		{" NB ", "Norwegian Bokmål"},
+		{"dag", "Dagbani"},
+		{"cy", "Welsh"},
+		{"xx-invalid-", "XX-INVALID-"},
+	}
+
+	for _, tt := range tests {
+		if got := EnglishName(tt.code); got != tt.want {
+			t.Errorf("EnglishName(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestDirection(t *testing.T) {
+	tests := []struct {
+		script string
+		want   string
+	}{
+		{"Arab", DirectionRTL},
+		{"Hebr", DirectionRTL},
+		{"Thaa", DirectionRTL},
+		{"Latn", DirectionLTR},
+		{"Cyrl", DirectionLTR},
+		{"", DirectionLTR},
+	}
+
+	for _, tt := range tests {
+		if got := Direction(tt.script); got != tt.want {
+			t.Errorf("Direction(%q) = %q, want %q", tt.script, got, tt.want)
+		}
+	}
+}
+
+// MARK: Display Language
+
+func TestDisplayLanguage(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		want           language.Base
+	}{
+		{"", language.MustParseBase("en")},
+		{"de-CH, de;q=0.9", language.MustParseBase("de")},
+		{"fr;q=0.5, sv", language.MustParseBase("sv")},
+		{"*", language.MustParseBase("en")},
+		{"not;q=a header;", language.MustParseBase("en")},
+	}
+
+	for _, tt := range tests {
+		got, _ := DisplayLanguage(tt.acceptLanguage).Base()
+		if got != tt.want {
+			t.Errorf("DisplayLanguage(%q) base = %v, want %v", tt.acceptLanguage, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package testdb provides in-memory SQLite databases for tests of code written against MariaDB.
+// The databases emulate the parts of information_schema and the MariaDB functions the server relies on.
+package testdb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"sync"
+	"testing"
+
+	sqlite "github.com/glebarez/go-sqlite"
+)
+
+// registerFunctions adds the MariaDB functions used by the server's queries to new SQLite connections.
+var registerFunctions = sync.OnceFunc(func() {
+	sqlite.MustRegisterDeterministicScalarFunction("substring_index", 3, substringIndex)
+	sqlite.MustRegisterDeterministicScalarFunction("database", 0, currentDatabase)
+})
+
+// catalog emulates the information_schema tables and columns the server queries.
+var catalog = []string{
+	"ATTACH DATABASE ':memory:' AS information_schema",
+	`CREATE TABLE information_schema.TABLES (
+		TABLE_SCHEMA TEXT, TABLE_NAME TEXT, CREATE_TIME DATETIME, PRIMARY KEY (TABLE_SCHEMA, TABLE_NAME)
+	)`,
+	`CREATE TABLE information_schema.COLUMNS (
+		TABLE_SCHEMA TEXT, TABLE_NAME TEXT, COLUMN_NAME TEXT, ORDINAL_POSITION INTEGER,
+		COLUMN_TYPE TEXT, DATA_TYPE TEXT, CHARACTER_MAXIMUM_LENGTH INTEGER
+	)`,
+}
+
+// MARK: Open
+
+// Open returns an in-memory database prepared by the given statements, closed once the test ends.
+// The tables the statements create are listed in information_schema under the empty schema name,
+// which is the database name the server queries while none is configured.
+func Open(t testing.TB, statements ...string) *sql.DB {
+	t.Helper()
+	registerFunctions()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("opening test database: %v", err)
+	}
+	// A single connection keeps the in-memory database and its attached catalog alive.
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	Exec(t, db, append(catalog, statements...)...)
+	return db
+}
+
+// Exec runs statements against a test database and updates its information_schema afterwards.
+func Exec(t testing.TB, db *sql.DB, statements ...string) {
+	t.Helper()
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("preparing test database: %v\n%s", err, statement)
+		}
+	}
+
+	if err := syncCatalog(db); err != nil {
+		t.Fatalf("listing test tables in information_schema: %v", err)
+	}
+}
+
+// MARK: Catalog
+
+// syncCatalog lists the current tables and their columns in information_schema.
+// Every table gets the rowID column MariaDB tables are migrated with, backed by SQLite's implicit rowid,
+// unless it is declared WITHOUT ROWID. Tables keep the creation time they were first listed with.
+func syncCatalog(db *sql.DB) error {
+	statements := []string{
+		"DELETE FROM information_schema.TABLES WHERE TABLE_NAME NOT IN (SELECT name FROM main.sqlite_master WHERE type = 'table')",
+		`INSERT OR IGNORE INTO information_schema.TABLES (TABLE_SCHEMA, TABLE_NAME, CREATE_TIME)
+			SELECT '', name, CURRENT_TIMESTAMP FROM main.sqlite_master WHERE type = 'table'`,
+		"DELETE FROM information_schema.COLUMNS",
+		`INSERT INTO information_schema.COLUMNS
+			SELECT '', m.name, p.name, p.cid + 1, lower(p.type),
+				lower(CASE WHEN instr(p.type, '(') > 0 THEN substr(p.type, 1, instr(p.type, '(') - 1) ELSE p.type END),
+				CASE WHEN instr(p.type, '(') > 0 THEN CAST(substr(p.type, instr(p.type, '(') + 1) AS INTEGER) END
+			FROM main.sqlite_master AS m, pragma_table_info(m.name) AS p
+			WHERE m.type = 'table'`,
+		`INSERT INTO information_schema.COLUMNS (TABLE_SCHEMA, TABLE_NAME, COLUMN_NAME, ORDINAL_POSITION, COLUMN_TYPE, DATA_TYPE)
+			SELECT '', name, 'rowID', 0, 'bigint unsigned', 'bigint'
+			FROM main.sqlite_master
+			WHERE type = 'table' AND upper(sql) NOT LIKE '%WITHOUT ROWID%'`,
+	}
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MARK: Functions
+
+// currentDatabase implements MariaDB's DATABASE(), naming the empty schema the test tables are listed under.
+func currentDatabase(*sqlite.FunctionContext, []driver.Value) (driver.Value, error) {
+	return "", nil
+}
+
+// substringIndex implements MariaDB's SUBSTRING_INDEX(str, delim, count) for a positive count.
+func substringIndex(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	if args[0] == nil || args[1] == nil || args[2] == nil {
+		return nil, nil
+	}
+
+	str, delim := toString(args[0]), toString(args[1])
+	count, _ := args[2].(int64)
+	if delim == "" || count <= 0 {
+		return "", nil
+	}
+
+	parts := strings.SplitN(str, delim, int(count)+1)
+	if len(parts) <= int(count) {
+		return str, nil
+	}
+	return strings.Join(parts[:count], delim), nil
+}
+
+// toString converts a text value passed to a function into a string.
+func toString(value driver.Value) string {
+	if b, ok := value.([]byte); ok {
+		return string(b)
+	}
+	s, _ := value.(string)
+	return s
+}
@@ -33,6 +33,52 @@ type ContractsResponse struct {
 	Contracts map[string]any `json:"contracts"`
 }
 
+// LanguageContractResponse represents the contract of a single language.
+// swagger:model LanguageContractResponse
+type LanguageContractResponse struct {
+	// ISO code of the language
+	Language string `json:"language"`
+	// Contract mapping each data type to the fields it is displayed with
+	Contract any `json:"contract"`
+}
+
+// ColumnTypeMismatch represents a contract column whose database type differs from the expected one.
+// swagger:model ColumnTypeMismatch
+type ColumnTypeMismatch struct {
+	// Name of the column
+	Column string `json:"column"`
+	// Type the contract requires, e.g. TEXT
+	Expected string `json:"expected"`
+	// Type of the column in the database, e.g. bigint(20)
+	Actual string `json:"actual"`
+}
+
+// DataTypeDrift represents the differences between the contract and the table of one data type.
+// swagger:model DataTypeDrift
+type DataTypeDrift struct {
+	// Whether the contract describes the data type
+	InContract bool `json:"in_contract"`
+	// Whether the database holds a table for the data type
+	HasTable bool `json:"has_table"`
+	// Columns referenced by the contract that the table lacks
+	MissingColumns []string `json:"missing_columns,omitempty"`
+	// Columns of the table that the contract does not mention, excluding the lexeme ID and modification time
+	UnexpectedColumns []string `json:"unexpected_columns,omitempty"`
+	// Columns referenced by the contract whose database type does not hold text
+	TypeMismatches []ColumnTypeMismatch `json:"type_mismatches,omitempty"`
+}
+
+// ContractValidationResponse represents the drift between the contract of a language and its database tables.
+// swagger:model ContractValidationResponse
+type ContractValidationResponse struct {
+	// ISO code of the language
+	Language string `json:"language"`
+	// Whether the tables match the contract
+	Valid bool `json:"valid"`
+	// Differences by data type
+	DataTypes map[string]DataTypeDrift `json:"data_types"`
+}
+
 // MARK: Language Data Models
 
 // LanguageDataResponse represents the complete response when fetching a language’s data.
@@ -42,8 +88,99 @@ type LanguageDataResponse struct {
 	Language string `json:"language"`
 	// Contract details defining the schema
 	Contract Contract `json:"contract"`
-	// Actual data, structured according to the contract
+	// Actual data by data type, structured according to the contract, as row objects or in the columnar layout
 	Data map[string]any `json:"data"`
+	// Lexeme IDs removed per data type since the requested time, only set for delta requests
+	Deleted map[string][]string `json:"deleted,omitempty"`
+	// Cursor for the next page, omitted when all rows have been served
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ColumnarRows holds the rows of a data type in the compact columnar layout, naming each column only once.
+// swagger:model ColumnarRows
+type ColumnarRows struct {
+	// Column names in the order of the values of each row
+	Columns []string `json:"columns"`
+	// Rows as lists of values, one per column
+	Rows [][]any `json:"rows"`
+}
+
+// LanguageDataTypeResponse represents the response when fetching a single data type of a language.
+// swagger:model LanguageDataTypeResponse
+type LanguageDataTypeResponse struct {
+	// ISO code of the language
+	Language string `json:"language"`
+	// Data type that was requested (e.g. "nouns")
+	DataType string `json:"data_type"`
+	// Contract details defining the schema of the data type
+	Contract Contract `json:"contract"`
+	// Rows of the data type, as objects or in the columnar layout (see ColumnarRows)
+	Data any `json:"data" swaggertype:"array,object"`
+	// Lexeme IDs removed since the requested time, only set for delta requests
+	Deleted []string `json:"deleted,omitempty"`
+	// Cursor for the next page, omitted when all rows have been served
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// LookupResponse represents the rows of a language in which a word appears, grouped by data type.
+// swagger:model LookupResponse
+type LookupResponse struct {
+	// ISO code of the language
+	Language string `json:"language"`
+	// Word that was looked up
+	Word string `json:"word"`
+	// Contract details defining the schema of each data type with matches
+	Contract Contract `json:"contract"`
+	// Matching rows by data type, omitting data types without matches
+	Results map[string][]map[string]any `json:"results"`
+	// Cursor to the further matches of data types with more matching rows than returned, omitted if all were returned
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// LexemeMatch represents the rows of a single table that carry a Wikidata lexeme ID.
+// swagger:model LexemeMatch
+type LexemeMatch struct {
+	// ISO code of the language, the source language for translation data
+	Language string `json:"language"`
+	// ISO code of the target language, only set for translation data
+	TargetLanguage string `json:"target_language,omitempty"`
+	// Data type the rows belong to, "translations" for translation data
+	DataType string `json:"data_type"`
+	// Contract field definitions of the table the rows were found in
+	Fields map[string]string `json:"fields"`
+	// Matching rows
+	Rows []map[string]any `json:"rows"`
+}
+
+// LexemeResponse represents the rows served for a Wikidata lexeme ID across all languages.
+// swagger:model LexemeResponse
+type LexemeResponse struct {
+	// Wikidata lexeme ID that was looked up
+	LexemeID string `json:"lexeme_id"`
+	// Matches by table, ordered by table name
+	Results []LexemeMatch `json:"results"`
+}
+
+// Completion represents a single term offered by the autocomplete endpoint.
+// swagger:model Completion
+type Completion struct {
+	// Lemma or form starting with the requested prefix
+	Term string `json:"term"`
+	// Data type the term was taken from
+	DataType string `json:"data_type"`
+	// Wikidata lexeme ID of the term
+	LexemeID string `json:"lexeme_id"`
+}
+
+// CompletionResponse represents the completions of a prefix in a language.
+// swagger:model CompletionResponse
+type CompletionResponse struct {
+	// ISO code of the language
+	Language string `json:"language"`
+	// Prefix that was completed
+	Prefix string `json:"prefix"`
+	// Matching terms in alphabetical order
+	Completions []Completion `json:"completions"`
 }
 
 // LanguageDataVersion represents a single record in the language_data_versions table.
@@ -65,11 +202,29 @@ type LanguageVersionResponse struct {
 	Versions map[string]string `json:"versions"`
 }
 
+// LanguageMetadata describes the names, script and identifiers of a language.
+// swagger:model LanguageMetadata
+type LanguageMetadata struct {
+	// Name of the language in English (e.g. "German")
+	EnglishName string `json:"english_name"`
+	// Name of the language in the language itself (e.g. "Deutsch")
+	NativeName string `json:"native_name"`
+	// Name of the language in the language requested via Accept-Language
+	DisplayName string `json:"display_name"`
+	// ISO 15924 code of the script the language is written in (e.g. "Latn")
+	Script string `json:"script,omitempty"`
+	// Text direction of the script, "ltr" or "rtl"
+	Direction string `json:"direction"`
+	// Wikidata item of the language (e.g. "Q188")
+	WikidataQID string `json:"wikidata_qid,omitempty"`
+}
+
 // LanguageInfo represents basic information about a supported language.
 // swagger:model LanguageInfo
 type LanguageInfo struct {
 	// ISO code of the language (e.g. "en", "fr")
 	Code string `json:"code"`
+	LanguageMetadata
 	// List of supported data types for this language
 	DataTypes []string `json:"data_types"`
 }
@@ -89,6 +244,10 @@ type TranslationEntry struct {
 	Description string `json:"description"`
 	// Translation of the word in the target language
 	Translation string `json:"translation"`
+	// Whether the translation was composed through an intermediate language
+	Pivoted bool `json:"pivoted,omitempty"`
+	// Word in the intermediate language the translation was composed through
+	Via string `json:"via,omitempty"`
 }
 
 // TranslationDataResponse represents translation data from a source language into a target language.
@@ -99,8 +258,52 @@ type TranslationDataResponse struct {
 	TargetLang string `json:"target_lang"`
 	// ISO code of the source language (e.g. "de")
 	SourceLang string `json:"source_lang"`
+	// ISO code of the intermediate language, only set if the data was composed through one
+	PivotLang string `json:"pivot_lang,omitempty"`
+	// Time the translation data was last migrated (RFC3339 format), to pass as since on the next sync
+	UpdatedAt string `json:"updated_at,omitempty"`
 	// Nested translation data
 	Data map[string]map[string]map[string]TranslationEntry `json:"data"`
+	// Cursor to request the next page, only set when more words remain
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// TranslationLookupResponse represents the translations of a single word from a source language into a target language.
+// Data is nested as: word -> wordType -> wordOrder -> TranslationEntry.
+// swagger:model TranslationLookupResponse
+type TranslationLookupResponse struct {
+	// ISO code of the target language (e.g. "bn")
+	TargetLang string `json:"target_lang"`
+	// ISO code of the source language (e.g. "de")
+	SourceLang string `json:"source_lang"`
+	// Word that was looked up
+	Word string `json:"word"`
+	// Whether the word was matched against translations rather than source words
+	Reverse bool `json:"reverse"`
+	// Nested translation data of the matching source words
+	Data map[string]map[string]map[string]TranslationEntry `json:"data"`
+}
+
+// TranslationPair describes the translation data available from a source language into a target language.
+// swagger:model TranslationPair
+type TranslationPair struct {
+	// ISO code of the source language
+	SourceLang string `json:"source_lang"`
+	// ISO code of the target language
+	TargetLang string `json:"target_lang"`
+	// Number of translation entries
+	RowCount int `json:"row_count"`
+	// Number of translation entries by word type
+	WordTypes map[string]int `json:"word_types"`
+	// Time the translation data was last migrated (RFC3339 format, nullable)
+	LastMigrated *string `json:"last_migrated"`
+}
+
+// TranslationPairsResponse lists all language pairs with translation data.
+// swagger:model TranslationPairsResponse
+type TranslationPairsResponse struct {
+	// Available language pairs ordered by target and source language
+	Pairs []TranslationPair `json:"pairs"`
 }
 
 // MARK: Statistics Models
@@ -112,8 +315,74 @@ type LanguageStatisticsReponse struct {
 	Code string `json:"code"`
 	// Human-readable language name (nullable)
 	LanguageName *string `json:"language_name"`
-	// Count of noun entries
+	// Count of noun entries (nullable)
 	Nouns *int `json:"nouns"`
-	// Count of verb entries
+	// Count of verb entries (nullable)
 	Verbs *int `json:"verbs"`
+	// Count of entries by data type
+	DataTypes map[string]int `json:"data_types"`
+	// Count of translation entries from this language by target language
+	Translations map[string]int `json:"translations"`
+	LanguageMetadata
+}
+
+// StatsSnapshot represents the row counts of a language recorded after one migration run.
+// swagger:model StatsSnapshot
+type StatsSnapshot struct {
+	// Time the snapshot was recorded (RFC3339 format)
+	RecordedAt string `json:"recorded_at"`
+	// Count of entries by data type
+	DataTypes map[string]int `json:"data_types"`
+}
+
+// LanguageStatsHistory represents the recorded statistics of a language over time.
+// swagger:model LanguageStatsHistory
+type LanguageStatsHistory struct {
+	// ISO code of the language
+	Code string `json:"code"`
+	LanguageMetadata
+	// Snapshots in chronological order
+	Snapshots []StatsSnapshot `json:"snapshots"`
+}
+
+// LanguageStatsHistoryResponse represents the statistics history of the requested languages.
+// swagger:model LanguageStatsHistoryResponse
+type LanguageStatsHistoryResponse struct {
+	// History by language, ordered by language code
+	Languages []LanguageStatsHistory `json:"languages"`
+}
+
+// ColumnCoverage represents how many rows of a data type hold a value for one column.
+// swagger:model ColumnCoverage
+type ColumnCoverage struct {
+	// Number of rows with a non-null, non-empty value
+	Filled int `json:"filled"`
+	// Share of rows with a value, in percent
+	Percentage float64 `json:"percentage"`
+	// Whether the language contract declares the column
+	InContract bool `json:"in_contract"`
+	// Whether the contract declares the column but the table lacks it, in which case it is reported at 0%
+	Missing bool `json:"missing,omitempty"`
+}
+
+// DataTypeCoverage represents the completeness of the columns of one data type.
+// swagger:model DataTypeCoverage
+type DataTypeCoverage struct {
+	// Number of rows
+	Rows int `json:"rows"`
+	// Average percentage over the columns declared by the contract (nullable)
+	ContractCoverage *float64 `json:"contract_coverage"`
+	// Completeness by column, excluding the lexeme ID and modification time, including contract columns the table lacks
+	Columns map[string]ColumnCoverage `json:"columns"`
+}
+
+// LanguageCoverageResponse represents the field completeness of every data type of a language.
+// swagger:model LanguageCoverageResponse
+type LanguageCoverageResponse struct {
+	// ISO code of the language
+	Code string `json:"code"`
+	// Whether a contract was found to compare the columns against
+	HasContract bool `json:"has_contract"`
+	// Completeness by data type
+	DataTypes map[string]DataTypeCoverage `json:"data_types"`
 }
@@ -305,6 +305,19 @@ ls -la "$PACKS_DIR"/*.sqlite | while read -r line; do
 done
 success "SQLite files copied successfully"
 
+# MARK: Precompress Packs
+
+# Zstandard sidecars (e.g. ENLanguageData.sqlite.zst) are served to clients that accept zstd.
+if command -v zstd &> /dev/null; then
+    log "🗜️  Creating zstd sidecars for SQLite files..."
+    for file in "$PACKS_DIR"/*.sqlite; do
+        zstd -q -19 -f "$file" -o "$file.zst" || warning "Failed to compress $file"
+    done
+    success "zstd sidecars created"
+else
+    warning "zstd not found, skipping precompressed SQLite sidecars"
+fi
+
 # MARK: Migration
 
 if [ "$SKIP_MIGRATION" != "true" ]; then
@@ -314,6 +327,9 @@ if [ "$SKIP_MIGRATION" != "true" ]; then
         exit 1
     }
     success "Database migration completed successfully"
+
+    log "🔍 Validating contracts against the database..."
+    go run ./cmd/validate-contracts -dir "$TEMP_DIR/$SCRIBE_DATA_DIR/$CONTRACTS_DIR" || warning "Database tables do not match the data contracts"
 else
     log "⏭️ Skipping migration (running in CI/CD)"
 fi